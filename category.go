@@ -0,0 +1,65 @@
+package timechart
+
+// Category tells what a schedule is for.
+// Charsets implementing CategoryChar fill each category differently.
+type Category uint8
+
+const (
+	Busy Category = iota
+	Tentative
+	OutOfOffice
+)
+
+// precedes returns whether c is drawn over other when both cover a cell.
+// OutOfOffice precedes Busy, which precedes Tentative.
+func (c Category) precedes(other Category) bool {
+	return c.rank() > other.rank()
+}
+
+func (c Category) rank() int {
+	switch c {
+	case Tentative:
+		return 0
+	case Busy:
+		return 1
+	case OutOfOffice:
+		return 2
+	default:
+		return -1
+	}
+}
+
+func (c Category) String() string {
+	switch c {
+	case Busy:
+		return "busy"
+	case Tentative:
+		return "tentative"
+	case OutOfOffice:
+		return "out of office"
+	default:
+		return "unknown"
+	}
+}
+
+// CategorizedSchedule is a Schedule of a Category.
+type CategorizedSchedule struct {
+	Schedule
+	Category Category
+}
+
+// CategoryChar is a Char which draws filled cells by their Category.
+type CategoryChar interface {
+	Char
+
+	Category(Category) Char
+}
+
+// categorize returns ss as Busy schedules.
+func categorize(ss []Schedule) []CategorizedSchedule {
+	cs := make([]CategorizedSchedule, len(ss))
+	for i, s := range ss {
+		cs[i] = CategorizedSchedule{Schedule: s, Category: Busy}
+	}
+	return cs
+}
@@ -23,17 +23,45 @@ type Char interface {
 	String() string
 }
 
+// PartialChar is a Char which draws a slot covered only partly.
+type PartialChar interface {
+	Char
+
+	// Partial marks that [from, to) of a slot is covered,
+	// where 0 is the start and 1 is the end of the slot.
+	Partial(from, to float64) Char
+}
+
+// CroppedChar is a Char which draws an edge of a window cutting off a schedule going on beyond it.
+type CroppedChar interface {
+	Char
+
+	// Cropped marks that a schedule filling the edge goes on beyond the window.
+	Cropped() Char
+}
+
 type UnicodeChar struct {
 	t charType
 
 	start bool
 	end   bool
 
-	now bool
-	in  bool
+	now      bool
+	in       bool
+	category Category
+
+	partial bool
+	head    bool // the start of a partial slot is covered
+	tail    bool // the end of a partial slot is covered
+
+	cropped bool
 }
 
-var _ Char = (*UnicodeChar)(nil)
+var (
+	_ CategoryChar = (*UnicodeChar)(nil)
+	_ PartialChar  = (*UnicodeChar)(nil)
+	_ CroppedChar  = (*UnicodeChar)(nil)
+)
 
 func NewUnicodeChar() Char {
 	return UnicodeChar{}
@@ -59,6 +87,23 @@ func (c UnicodeChar) Now() Char {
 	return c
 }
 
+func (c UnicodeChar) Category(category Category) Char {
+	c.category = category
+	return c
+}
+
+func (c UnicodeChar) Partial(from, to float64) Char {
+	c.partial = true
+	c.head = from <= 0
+	c.tail = to >= 1
+	return c
+}
+
+func (c UnicodeChar) Cropped() Char {
+	c.cropped = true
+	return c
+}
+
 func (c UnicodeChar) Hour() Char {
 	c.t = hour
 	return c
@@ -114,6 +159,8 @@ func (c UnicodeChar) edge() string {
 		return "┣"
 	case c.start && c.now:
 		return "┠"
+	case c.start && c.in && c.cropped:
+		return "╞"
 	case c.start && c.in:
 		return "┝"
 	case c.start:
@@ -122,6 +169,8 @@ func (c UnicodeChar) edge() string {
 		return "┫"
 	case c.now:
 		return "┨"
+	case c.in && c.cropped:
+		return "╡"
 	case c.in:
 		return "┥"
 	default:
@@ -131,6 +180,20 @@ func (c UnicodeChar) edge() string {
 
 func (c UnicodeChar) slot() string {
 	switch {
+	case c.now && c.in:
+		return "╋"
+	case c.now:
+		return "┃"
+	case c.in && c.partial && c.head && !c.tail:
+		return "╸"
+	case c.in && c.partial && c.tail && !c.head:
+		return "╺"
+	case c.in && c.partial:
+		return "╍"
+	case c.in && c.category == Tentative:
+		return "┅"
+	case c.in && c.category == OutOfOffice:
+		return "═"
 	case c.in:
 		return "━"
 	default:
@@ -138,6 +201,148 @@ func (c UnicodeChar) slot() string {
 	}
 }
 
+// ASCIIChar draws a chart with ASCII characters only, for consoles and viewers without box-drawing glyphs.
+// e.g. |--+--+==#==#==]|--+ where = is filled, and * or @ (if filled) marks now.
+// Tentative and OutOfOffice slots are filled with ~ and x, and partial slots with :.
+// Edges of a window cutting off a schedule are drawn as < and >.
+type ASCIIChar struct {
+	t charType
+
+	start bool
+	end   bool
+
+	now      bool
+	in       bool
+	category Category
+
+	partial bool
+	cropped bool
+}
+
+var (
+	_ CategoryChar = (*ASCIIChar)(nil)
+	_ PartialChar  = (*ASCIIChar)(nil)
+	_ CroppedChar  = (*ASCIIChar)(nil)
+)
+
+func NewASCIIChar() Char {
+	return ASCIIChar{}
+}
+
+func (c ASCIIChar) Start() Char {
+	c.start = true
+	return c
+}
+
+func (c ASCIIChar) End() Char {
+	c.end = true
+	return c
+}
+
+func (c ASCIIChar) Fill() Char {
+	c.in = true
+	return c
+}
+
+func (c ASCIIChar) Now() Char {
+	c.now = true
+	return c
+}
+
+func (c ASCIIChar) Category(category Category) Char {
+	c.category = category
+	return c
+}
+
+func (c ASCIIChar) Partial(from, to float64) Char {
+	c.partial = true
+	return c
+}
+
+func (c ASCIIChar) Cropped() Char {
+	c.cropped = true
+	return c
+}
+
+func (c ASCIIChar) Hour() Char {
+	c.t = hour
+	return c
+}
+
+func (c ASCIIChar) Edge() Char {
+	c.t = edge
+	return c
+}
+
+func (c ASCIIChar) Slot() Char {
+	c.t = slot
+	return c
+}
+
+func (c ASCIIChar) String() string {
+	switch c.t {
+	case hour:
+		return c.hour()
+	case edge:
+		return c.edge()
+	case slot:
+		return c.slot()
+	default:
+		return ""
+	}
+}
+
+func (c ASCIIChar) hour() string {
+	switch {
+	case c.now && c.in:
+		return "@"
+	case c.now:
+		return "*"
+	case c.in:
+		return "#"
+	default:
+		return "+"
+	}
+}
+
+func (c ASCIIChar) edge() string {
+	switch {
+	case c.now && c.in:
+		return "@"
+	case c.now:
+		return "*"
+	case c.start && c.in && c.cropped:
+		return "<"
+	case c.in && c.cropped:
+		return ">"
+	case c.start && c.in:
+		return "["
+	case c.in:
+		return "]"
+	default:
+		return "|"
+	}
+}
+
+func (c ASCIIChar) slot() string {
+	switch {
+	case c.now && c.in:
+		return "@"
+	case c.now:
+		return "*"
+	case c.in && c.partial:
+		return ":"
+	case c.in && c.category == Tentative:
+		return "~"
+	case c.in && c.category == OutOfOffice:
+		return "x"
+	case c.in:
+		return "="
+	default:
+		return "-"
+	}
+}
+
 type Chars []Char
 
 func (cc Chars) String() string {
@@ -0,0 +1,65 @@
+package timechart
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestASCIIChar(t *testing.T) {
+	cases := []struct {
+		name      string
+		schedules []Schedule
+		t         time.Time
+		expected  string
+	}{
+		{
+			name:     "empty",
+			t:        time.Date(1970, 1, 1, 10, 0, 0, 0, time.UTC),
+			expected: "|--+--+--+--+--+--+--+--+--+--*--+--||--+--+--+--+--+--+--+--+--+--+--+--|",
+		},
+		{
+			name: "hours",
+			schedules: []Schedule{
+				{newTime(16, 0), newTime(19, 0)},
+			},
+			t:        time.Date(1970, 1, 1, 17, 0, 0, 0, time.UTC),
+			expected: "|--+--+--+--+--+--+--+--+--+--+--+--||--+--+--+--#==@==#==#--+--+--+--+--|",
+		},
+		{
+			name: "in a slot",
+			schedules: []Schedule{
+				{newTime(16, 0), newTime(19, 0)},
+			},
+			t:        time.Date(1970, 1, 1, 17, 40, 0, 0, time.UTC),
+			expected: "|--+--+--+--+--+--+--+--+--+--+--+--||--+--+--+--#==#=@#==#--+--+--+--+--|",
+		},
+		{
+			name: "both sides of noon",
+			schedules: []Schedule{
+				{newTime(0, 0), newTime(1, 0)},
+				{newTime(11, 30), newTime(12, 30)},
+			},
+			t:        time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: "@==#--+--+--+--+--+--+--+--+--+--+-=][=-+--+--+--+--+--+--+--+--+--+--+--|",
+		},
+		{
+			name: "partial slots",
+			schedules: []Schedule{
+				{newTime(10, 10), newTime(12, 20)},
+			},
+			t:        time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: "*--+--+--+--+--+--+--+--+--+--+:=#==][:-+--+--+--+--+--+--+--+--+--+--+--|",
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			f := NewHalfHourIncrementFormatter(NewASCIIChar, WithPartialSlots())
+
+			got := f.FormatWithTime(tc.schedules, tc.t)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
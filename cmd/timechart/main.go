@@ -0,0 +1,135 @@
+// Command timechart draws a chart of schedules of a day.
+//
+// Schedules are read from the arguments, or else from a file or stdin, one or more on each line:
+//
+//	$ timechart 09:00-12:30, 13:30-18:00
+//	$ echo "22:00-02:00+1" | timechart -charset ascii -now 23:00
+//	$ timechart -file schedules.txt -window 08:00-20:00
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/JungWinter/go-timechart"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run draws the schedules given by args or stdin to stdout and returns the exit code.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("timechart", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "usage: timechart [flags] [schedules]")
+		fs.PrintDefaults()
+	}
+	charset := fs.String("charset", "unicode", "`name` of the characters to draw with: unicode or ascii")
+	resolution := fs.Duration("resolution", 30*time.Minute, "how long each slot lasts")
+	window := fs.String("window", "", "the `times` of day to draw only, e.g. 08:00-20:00")
+	now := fs.String("now", "", "the `time` to mark: now, a time of day like 10:30 or an RFC 3339 time")
+	file := fs.String("file", "", "the `path` to read schedules from instead of stdin, - for stdin")
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+
+	f, err := newFormatter(*charset, *resolution, *window)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+	ss, err := readSchedules(fs.Args(), *file, stdin)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	if *now == "" {
+		fmt.Fprintln(stdout, f.Format(ss))
+		return 0
+	}
+	t, err := parseNow(*now)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+	fmt.Fprintln(stdout, f.FormatWithTime(ss, t))
+	return 0
+}
+
+func newFormatter(charset string, resolution time.Duration, window string) (timechart.IncrementFormatter, error) {
+	var fn func() timechart.Char
+	switch charset {
+	case "unicode":
+		fn = timechart.NewUnicodeChar
+	case "ascii":
+		fn = timechart.NewASCIIChar
+	default:
+		return timechart.IncrementFormatter{}, fmt.Errorf("timechart: unknown charset %q", charset)
+	}
+
+	var opts []timechart.Option
+	if window != "" {
+		w, err := timechart.ParseSchedule(window)
+		if err != nil {
+			return timechart.IncrementFormatter{}, err
+		}
+		midnight := timechart.NewTime(0, 0, 0)
+		opts = append(opts, timechart.WithWindow(w.Start.Sub(midnight), w.End.Sub(midnight)))
+	}
+	return timechart.NewIncrementFormatter(fn, resolution, opts...)
+}
+
+// readSchedules returns the schedules of args, or else of the file at path or stdin.
+func readSchedules(args []string, path string, stdin io.Reader) ([]timechart.Schedule, error) {
+	if len(args) > 0 {
+		return timechart.ParseSchedules(strings.Join(args, " "))
+	}
+
+	r := stdin
+	if path != "" && path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("timechart: %w", err)
+		}
+		defer file.Close()
+		r = file
+	}
+
+	var ss []timechart.Schedule
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		parsed, err := timechart.ParseSchedules(scanner.Text())
+		if err != nil {
+			return nil, fmt.Errorf("%w (line %d)", err, line)
+		}
+		ss = append(ss, parsed...)
+	}
+	return ss, scanner.Err()
+}
+
+// parseNow parses the time given by -now.
+func parseNow(s string) (time.Time, error) {
+	if s == "now" {
+		return time.Now(), nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("timechart: invalid time %q", s)
+	}
+	return timechart.NewTime(t.Hour(), t.Minute(), 0), nil
+}
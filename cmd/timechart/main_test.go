@@ -0,0 +1,106 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "schedules.txt")
+	assert.NoError(t, os.WriteFile(path, []byte("09:00-12:00\n\n13:00-14:00, 15:00-15:30\n"), 0o644))
+
+	cases := []struct {
+		name     string
+		args     []string
+		stdin    string
+		code     int
+		expected string
+		err      string
+	}{
+		{
+			name:     "arguments",
+			args:     []string{"09:00-12:30,", "13:30-18:00"},
+			expected: "├──┼──┼──┼──┼──┼──┼──┼──┼──┾━━┿━━┿━━┥┝━─┼─━┿━━┿━━┿━━┿━━┽──┼──┼──┼──┼──┼──┤\n",
+		},
+		{
+			name:     "stdin",
+			stdin:    "22:00-02:00+1\n",
+			args:     []string{"-charset", "ascii", "-now", "23:00"},
+			expected: "|--+--+--+--+--+--+--+--+--+--+--+--||--+--+--+--+--+--+--+--+--+--#==@==]\n",
+		},
+		{
+			name:     "file",
+			args:     []string{"-file", path, "-resolution", "1h", "-window", "08:00-20:00"},
+			expected: "├─┾━┿━┿━┥├─┾━┽─┿─┼─┼─┼─┼─┤\n",
+		},
+		{
+			name:     "instant",
+			args:     []string{"-now", "2022-02-01T10:00:00Z", "9-11"},
+			expected: "├──┼──┼──┼──┼──┼──┼──┼──┼──┾━━╋━━┽──┤├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤\n",
+		},
+		{
+			name: "invalid schedule",
+			args: []string{"9-noon"},
+			code: 1,
+			err:  "timechart: parsing \"9-noon\" at 2: expected hour\n",
+		},
+		{
+			name:  "invalid line",
+			stdin: "9-10\n10\n",
+			code:  1,
+			err:   "timechart: parsing \"10\" at 2: expected '-' (line 2)\n",
+		},
+		{
+			name: "unknown charset",
+			args: []string{"-charset", "emoji", "9-10"},
+			code: 2,
+			err:  "timechart: unknown charset \"emoji\"\n",
+		},
+		{
+			name: "invalid resolution",
+			args: []string{"-resolution", "7m", "9-10"},
+			code: 2,
+			err:  "timechart: invalid resolution\n",
+		},
+		{
+			name: "window over midnight",
+			args: []string{"-window", "22-02+1", "9-10"},
+			code: 2,
+			err:  "timechart: invalid window\n",
+		},
+		{
+			name: "inverted window",
+			args: []string{"-window", "20-08", "9-10"},
+			code: 2,
+			err:  "timechart: parsing \"20-08\" at 3: ends before it starts, add +1 to end on the next day\n",
+		},
+		{
+			name: "empty window",
+			args: []string{"-window", "10-10", "9-10"},
+			code: 2,
+			err:  "timechart: invalid window\n",
+		},
+		{
+			name: "invalid now",
+			args: []string{"-now", "noon", "9-10"},
+			code: 2,
+			err:  "timechart: invalid time \"noon\"\n",
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+
+			code := run(tc.args, strings.NewReader(tc.stdin), &stdout, &stderr)
+			assert.Equal(t, tc.code, code)
+			assert.Equal(t, tc.expected, stdout.String())
+			assert.Equal(t, tc.err, stderr.String())
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package timechart
+
+import (
+	"io"
+	"os"
+)
+
+// Palette holds SGR parameters, e.g. "32" or "1;31", coloring each kind of cell.
+// An empty parameter leaves those cells as they are.
+type Palette struct {
+	Fill string // filled slots, hours and edges
+	Now  string // the cell marking now
+	Edge string // empty hours and edges
+	Gap  string // empty slots
+}
+
+// DefaultPalette draws filled cells in green and now in bold red, dimming hours and edges.
+var DefaultPalette = Palette{
+	Fill: "32",
+	Now:  "1;31",
+	Edge: "2",
+}
+
+// ColorChar wraps a Char to color it with ANSI escape sequences.
+type ColorChar struct {
+	Char
+
+	palette Palette
+	t       charType
+	now     bool
+	in      bool
+}
+
+var (
+	_ CategoryChar = (*ColorChar)(nil)
+	_ PartialChar  = (*ColorChar)(nil)
+	_ CroppedChar  = (*ColorChar)(nil)
+)
+
+// NewColorCharset returns a charset coloring the chars of charset by p.
+func NewColorCharset(charset func() Char, p Palette) func() Char {
+	return func() Char {
+		return ColorChar{Char: charset(), palette: p}
+	}
+}
+
+// NewAutoColorCharset is like NewColorCharset,
+// but returns charset as it is unless ColorEnabled(w).
+func NewAutoColorCharset(charset func() Char, p Palette, w io.Writer) func() Char {
+	if !ColorEnabled(w) {
+		return charset
+	}
+	return NewColorCharset(charset, p)
+}
+
+// ColorEnabled returns whether w is a terminal accepting colors.
+// It follows https://no-color.org and also returns false if TERM is dumb.
+func ColorEnabled(w io.Writer) bool {
+	if os.Getenv("NO_COLOR") != "" || os.Getenv("TERM") == "dumb" {
+		return false
+	}
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return fi.Mode()&os.ModeCharDevice != 0
+}
+
+func (c ColorChar) Start() Char {
+	c.Char = c.Char.Start()
+	return c
+}
+
+func (c ColorChar) End() Char {
+	c.Char = c.Char.End()
+	return c
+}
+
+func (c ColorChar) Fill() Char {
+	c.Char = c.Char.Fill()
+	c.in = true
+	return c
+}
+
+func (c ColorChar) Now() Char {
+	c.Char = c.Char.Now()
+	c.now = true
+	return c
+}
+
+// Category draws c by category if the wrapped Char is a CategoryChar.
+func (c ColorChar) Category(category Category) Char {
+	if cc, ok := c.Char.(CategoryChar); ok {
+		c.Char = cc.Category(category)
+	}
+	return c
+}
+
+// Partial draws c as a partial slot if the wrapped Char is a PartialChar.
+func (c ColorChar) Partial(from, to float64) Char {
+	if pc, ok := c.Char.(PartialChar); ok {
+		c.Char = pc.Partial(from, to)
+	}
+	return c
+}
+
+// Cropped draws c as a cropped edge if the wrapped Char is a CroppedChar.
+func (c ColorChar) Cropped() Char {
+	if cc, ok := c.Char.(CroppedChar); ok {
+		c.Char = cc.Cropped()
+	}
+	return c
+}
+
+func (c ColorChar) Hour() Char {
+	c.Char = c.Char.Hour()
+	c.t = hour
+	return c
+}
+
+func (c ColorChar) Edge() Char {
+	c.Char = c.Char.Edge()
+	c.t = edge
+	return c
+}
+
+func (c ColorChar) Slot() Char {
+	c.Char = c.Char.Slot()
+	c.t = slot
+	return c
+}
+
+func (c ColorChar) String() string {
+	s := c.Char.String()
+	sgr := c.sgr()
+	if s == "" || sgr == "" {
+		return s
+	}
+	return "\x1b[" + sgr + "m" + s + "\x1b[0m"
+}
+
+func (c ColorChar) sgr() string {
+	switch {
+	case c.now:
+		return c.palette.Now
+	case c.in:
+		return c.palette.Fill
+	case c.t == slot:
+		return c.palette.Gap
+	default:
+		return c.palette.Edge
+	}
+}
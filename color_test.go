@@ -0,0 +1,79 @@
+package timechart
+
+import (
+	"bytes"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColorChar(t *testing.T) {
+	p := Palette{Fill: "32", Now: "31", Edge: "2", Gap: "34"}
+	cases := []struct {
+		name     string
+		c        Char
+		expected string
+	}{
+		{
+			name:     "gap",
+			c:        NewColorCharset(NewASCIIChar, p)().Slot(),
+			expected: "\x1b[34m-\x1b[0m",
+		},
+		{
+			name:     "filled slot",
+			c:        NewColorCharset(NewASCIIChar, p)().Slot().Fill(),
+			expected: "\x1b[32m=\x1b[0m",
+		},
+		{
+			name:     "hour",
+			c:        NewColorCharset(NewASCIIChar, p)().Hour(),
+			expected: "\x1b[2m+\x1b[0m",
+		},
+		{
+			name:     "now",
+			c:        NewColorCharset(NewASCIIChar, p)().Hour().Fill().Now(),
+			expected: "\x1b[31m@\x1b[0m",
+		},
+		{
+			name:     "no parameter",
+			c:        NewColorCharset(NewASCIIChar, Palette{})().Edge().Start(),
+			expected: "|",
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.c.String())
+		})
+	}
+}
+
+func TestColorChar_Format(t *testing.T) {
+	f := NewHalfHourIncrementFormatter(NewColorCharset(NewASCIIChar, Palette{Fill: "32", Now: "31"}))
+	schedules := []Schedule{
+		{newTime(23, 0), newTime(24, 0)},
+	}
+
+	got := f.FormatWithTime(schedules, time.Date(1970, 1, 1, 23, 0, 0, 0, time.UTC))
+	assert.Equal(t, "|--+--+--+--+--+--+--+--+--+--+--+--||--+--+--+--+--+--+--+--+--+--+--\x1b[31m@\x1b[0m\x1b[32m=\x1b[0m\x1b[32m=\x1b[0m\x1b[32m]\x1b[0m", got)
+}
+
+func TestColorEnabled(t *testing.T) {
+	t.Run("not a file", func(t *testing.T) {
+		assert.False(t, ColorEnabled(&bytes.Buffer{}))
+	})
+	t.Run("not a terminal", func(t *testing.T) {
+		f, err := os.CreateTemp(t.TempDir(), "chart")
+		assert.NoError(t, err)
+		defer f.Close()
+
+		assert.False(t, ColorEnabled(f))
+		assert.Equal(t, "-", NewAutoColorCharset(NewASCIIChar, DefaultPalette, f)().Slot().String())
+	})
+	t.Run("NO_COLOR", func(t *testing.T) {
+		t.Setenv("NO_COLOR", "1")
+		assert.False(t, ColorEnabled(os.Stdout))
+	})
+}
@@ -0,0 +1,199 @@
+package timechart
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// scheduleJSON is the JSON object of a Schedule of instants.
+type scheduleJSON struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// MarshalText encodes s as ParseSchedule parses it, e.g. "09:00-12:30" or "22:00-02:00+1",
+// if s is of times of day in whole minutes as made by NewTime.
+// Otherwise s is encoded as an RFC 3339 interval, e.g. "2022-02-01T09:00:00Z/2022-02-01T12:30:00Z".
+func (s Schedule) MarshalText() ([]byte, error) {
+	if s.isClock() {
+		return []byte(s.clockText()), nil
+	}
+	start, err := s.Start.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	end, err := s.End.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return []byte(string(start) + "/" + string(end)), nil
+}
+
+// UnmarshalText decodes a schedule encoded by MarshalText.
+func (s *Schedule) UnmarshalText(text []byte) error {
+	str := string(text)
+	i := strings.IndexByte(str, '/')
+	if i < 0 {
+		schedule, err := ParseSchedule(str)
+		if err != nil {
+			return err
+		}
+		*s = schedule
+		return nil
+	}
+
+	var schedule Schedule
+	if err := schedule.Start.UnmarshalText([]byte(str[:i])); err != nil {
+		return fmt.Errorf("timechart: start of %q: %w", str, err)
+	}
+	if err := schedule.End.UnmarshalText([]byte(str[i+1:])); err != nil {
+		return fmt.Errorf("timechart: end of %q: %w", str, err)
+	}
+	*s = schedule
+	return nil
+}
+
+// MarshalJSON encodes s as an object of RFC 3339 instants, e.g. {"start":"2022-02-01T09:00:00Z","end":…},
+// or as a string of MarshalText if s is of times of day, e.g. "09:00-12:30".
+func (s Schedule) MarshalJSON() ([]byte, error) {
+	if s.isClock() {
+		return json.Marshal(s.clockText())
+	}
+	return json.Marshal(scheduleJSON{Start: s.Start, End: s.End})
+}
+
+// UnmarshalJSON decodes a schedule encoded by MarshalJSON, as an object or a string.
+func (s *Schedule) UnmarshalJSON(data []byte) error {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte(`"`)) {
+		var text string
+		if err := json.Unmarshal(data, &text); err != nil {
+			return err
+		}
+		return s.UnmarshalText([]byte(text))
+	}
+
+	var v scheduleJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*s = NewSchedule(v.Start, v.End)
+	return nil
+}
+
+// isClock returns whether s is of times of day in whole minutes, so that it can be written like "09:00-12:30".
+func (s Schedule) isClock() bool {
+	return isTimeOfDay(s.Start) && isTimeOfDay(s.End) &&
+		s.Start.Location() == time.UTC && s.End.Location() == time.UTC &&
+		s.Start.Second() == 0 && s.Start.Nanosecond() == 0 &&
+		s.End.Second() == 0 && s.End.Nanosecond() == 0 &&
+		timeLTE(s.Start, s.End)
+}
+
+// clockText returns s like "09:00-12:30", where it ends at "24:00" or "+N" days later past a midnight.
+func (s Schedule) clockText() string {
+	text := s.Start.Format("15:04") + "-"
+	switch days := daysBetween(s.Start, s.End); {
+	case days == 0:
+		return text + s.End.Format("15:04")
+	case days == 1 && s.End.Hour() == 0 && s.End.Minute() == 0:
+		return text + "24:00"
+	default:
+		return text + fmt.Sprintf("%s+%d", s.End.Format("15:04"), days)
+	}
+}
+
+// MarshalText encodes c as its String.
+func (c Category) MarshalText() ([]byte, error) {
+	if c.rank() < 0 {
+		return nil, fmt.Errorf("timechart: unknown category %d", c)
+	}
+	return []byte(c.String()), nil
+}
+
+// UnmarshalText decodes a category encoded by MarshalText.
+func (c *Category) UnmarshalText(text []byte) error {
+	for _, category := range []Category{Busy, Tentative, OutOfOffice} {
+		if string(text) == category.String() {
+			*c = category
+			return nil
+		}
+	}
+	return fmt.Errorf("timechart: unknown category %q", text)
+}
+
+// MarshalText encodes f as its String, like FREQ of an RRULE.
+func (f Frequency) MarshalText() ([]byte, error) {
+	if f > Yearly {
+		return nil, fmt.Errorf("timechart: unknown frequency %d", f)
+	}
+	return []byte(f.String()), nil
+}
+
+// UnmarshalText decodes a frequency encoded by MarshalText.
+func (f *Frequency) UnmarshalText(text []byte) error {
+	freq, err := parseFrequency(string(text))
+	if err != nil {
+		return fmt.Errorf("timechart: frequency: %w", err)
+	}
+	*f = freq
+	return nil
+}
+
+// categorizedScheduleJSON is the JSON object of a CategorizedSchedule.
+type categorizedScheduleJSON struct {
+	Schedule Schedule `json:"schedule"`
+	Category Category `json:"category"`
+}
+
+// MarshalJSON encodes c as an object of its schedule and category,
+// e.g. {"schedule":"09:00-12:30","category":"tentative"}.
+func (c CategorizedSchedule) MarshalJSON() ([]byte, error) {
+	return json.Marshal(categorizedScheduleJSON{Schedule: c.Schedule, Category: c.Category})
+}
+
+// UnmarshalJSON decodes a categorized schedule encoded by MarshalJSON.
+func (c *CategorizedSchedule) UnmarshalJSON(data []byte) error {
+	var v categorizedScheduleJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*c = CategorizedSchedule{Schedule: v.Schedule, Category: v.Category}
+	return nil
+}
+
+// MarshalText encodes c as the text of its schedule followed by its category,
+// e.g. "09:00-12:30 tentative".
+func (c CategorizedSchedule) MarshalText() ([]byte, error) {
+	schedule, err := c.Schedule.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	category, err := c.Category.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return []byte(string(schedule) + " " + string(category)), nil
+}
+
+// UnmarshalText decodes a categorized schedule encoded by MarshalText,
+// where a schedule without a category is Busy.
+func (c *CategorizedSchedule) UnmarshalText(text []byte) error {
+	str := strings.TrimSpace(string(text))
+	category := Busy
+	if i := strings.IndexByte(str, ' '); i >= 0 {
+		if err := category.UnmarshalText([]byte(str[i+1:])); err != nil {
+			return err
+		}
+		str = str[:i]
+	}
+
+	var schedule Schedule
+	if err := schedule.UnmarshalText([]byte(str)); err != nil {
+		return err
+	}
+	*c = CategorizedSchedule{Schedule: schedule, Category: category}
+	return nil
+}
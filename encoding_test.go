@@ -0,0 +1,189 @@
+package timechart
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchedule_MarshalText(t *testing.T) {
+	seoul, err := time.LoadLocation("Asia/Seoul")
+	assert.NoError(t, err)
+
+	cases := []struct {
+		name     string
+		schedule Schedule
+		expected string
+	}{
+		{
+			name:     "times of day",
+			schedule: Schedule{newTime(9, 0), newTime(12, 30)},
+			expected: "09:00-12:30",
+		},
+		{
+			name:     "until midnight",
+			schedule: Schedule{newTime(18, 0), newTime(24, 0)},
+			expected: "18:00-24:00",
+		},
+		{
+			name:     "over midnight",
+			schedule: Schedule{newTime(22, 0), newTime(26, 0)},
+			expected: "22:00-02:00+1",
+		},
+		{
+			name:     "seconds",
+			schedule: Schedule{NewTime(9, 0, 30), newTime(10, 0)},
+			expected: "0001-01-01T09:00:30Z/0001-01-01T10:00:00Z",
+		},
+		{
+			name:     "instants",
+			schedule: Schedule{time.Date(2022, 2, 1, 9, 0, 0, 0, seoul), time.Date(2022, 2, 1, 12, 30, 0, 0, seoul)},
+			expected: "2022-02-01T09:00:00+09:00/2022-02-01T12:30:00+09:00",
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			text, err := tc.schedule.MarshalText()
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, string(text))
+
+			var got Schedule
+			assert.NoError(t, got.UnmarshalText(text))
+			assert.True(t, tc.schedule.Start.Equal(got.Start), "start")
+			assert.True(t, tc.schedule.End.Equal(got.End), "end")
+		})
+	}
+}
+
+func TestSchedule_UnmarshalText(t *testing.T) {
+	cases := []struct {
+		text string
+	}{
+		{text: "9-noon"},
+		{text: "2022-02-01T09:00:00Z/noon"},
+		{text: "morning/2022-02-01T09:00:00Z"},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.text, func(t *testing.T) {
+			var got Schedule
+			assert.Error(t, got.UnmarshalText([]byte(tc.text)))
+		})
+	}
+}
+
+func TestSchedule_MarshalJSON(t *testing.T) {
+	cases := []struct {
+		name     string
+		schedule Schedule
+		expected string
+	}{
+		{
+			name:     "times of day",
+			schedule: Schedule{newTime(9, 0), newTime(12, 30)},
+			expected: `"09:00-12:30"`,
+		},
+		{
+			name:     "instants",
+			schedule: Schedule{time.Date(2022, 2, 1, 9, 0, 0, 0, time.UTC), time.Date(2022, 2, 1, 12, 30, 0, 0, time.UTC)},
+			expected: `{"start":"2022-02-01T09:00:00Z","end":"2022-02-01T12:30:00Z"}`,
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := json.Marshal(tc.schedule)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, string(data))
+
+			var got Schedule
+			assert.NoError(t, json.Unmarshal(data, &got))
+			assert.True(t, tc.schedule.Start.Equal(got.Start), "start")
+			assert.True(t, tc.schedule.End.Equal(got.End), "end")
+		})
+	}
+}
+
+func TestSchedule_UnmarshalJSON(t *testing.T) {
+	var got []Schedule
+	err := json.Unmarshal([]byte(`[
+		"9-17",
+		"2022-02-01T09:00:00Z/2022-02-01T10:00:00Z",
+		{"start": "2022-02-01T11:00:00Z", "end": "2022-02-01T12:00:00Z"}
+	]`), &got)
+	assert.NoError(t, err)
+	assert.Equal(t, []Schedule{
+		{newTime(9, 0), newTime(17, 0)},
+		{time.Date(2022, 2, 1, 9, 0, 0, 0, time.UTC), time.Date(2022, 2, 1, 10, 0, 0, 0, time.UTC)},
+		{time.Date(2022, 2, 1, 11, 0, 0, 0, time.UTC), time.Date(2022, 2, 1, 12, 0, 0, 0, time.UTC)},
+	}, got)
+
+	assert.Error(t, json.Unmarshal([]byte(`"22:00-02:00"`), &Schedule{}))
+	assert.Error(t, json.Unmarshal([]byte(`{"start": "noon"}`), &Schedule{}))
+}
+
+func TestCategorizedSchedule_MarshalJSON(t *testing.T) {
+	cs := []CategorizedSchedule{
+		{Schedule: Schedule{newTime(9, 0), newTime(10, 0)}, Category: Busy},
+		{Schedule: Schedule{newTime(13, 0), newTime(14, 0)}, Category: OutOfOffice},
+	}
+
+	data, err := json.Marshal(cs)
+	assert.NoError(t, err)
+	assert.Equal(t, `[{"schedule":"09:00-10:00","category":"busy"},{"schedule":"13:00-14:00","category":"out of office"}]`, string(data))
+
+	var got []CategorizedSchedule
+	assert.NoError(t, json.Unmarshal(data, &got))
+	assert.Equal(t, cs, got)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"schedule":"09:00-10:00","category":"lunch"}`), &CategorizedSchedule{}))
+}
+
+func TestCategorizedSchedule_MarshalText(t *testing.T) {
+	cases := []struct {
+		name     string
+		schedule CategorizedSchedule
+		expected string
+	}{
+		{
+			name:     "busy",
+			schedule: CategorizedSchedule{Schedule: Schedule{newTime(9, 0), newTime(10, 0)}, Category: Busy},
+			expected: "09:00-10:00 busy",
+		},
+		{
+			name:     "out of office",
+			schedule: CategorizedSchedule{Schedule: Schedule{newTime(22, 0), newTime(26, 0)}, Category: OutOfOffice},
+			expected: "22:00-02:00+1 out of office",
+		},
+		{
+			name: "instants",
+			schedule: CategorizedSchedule{
+				Schedule: Schedule{time.Date(2022, 2, 1, 9, 0, 0, 0, time.UTC), time.Date(2022, 2, 1, 10, 0, 0, 0, time.UTC)},
+				Category: Tentative,
+			},
+			expected: "2022-02-01T09:00:00Z/2022-02-01T10:00:00Z tentative",
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			text, err := tc.schedule.MarshalText()
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, string(text))
+
+			var got CategorizedSchedule
+			assert.NoError(t, got.UnmarshalText(text))
+			assert.True(t, tc.schedule.Start.Equal(got.Start), "start")
+			assert.True(t, tc.schedule.End.Equal(got.End), "end")
+			assert.Equal(t, tc.schedule.Category, got.Category)
+		})
+	}
+
+	var got CategorizedSchedule
+	assert.NoError(t, got.UnmarshalText([]byte("9-17")))
+	assert.Equal(t, CategorizedSchedule{Schedule: Schedule{newTime(9, 0), newTime(17, 0)}, Category: Busy}, got)
+	assert.Error(t, got.UnmarshalText([]byte("9-17 lunch")))
+}
@@ -59,3 +59,19 @@ func ExampleHalfHourIncrementFormatter_FormatWithTime() {
 	// ├──┼──┼──┾━━┿━━┿━━┿━━┿━━┿━━┿━━╋━─┼──┤├─━┿━━┿━━┿━━┿━━┿━─┼──┼──┼──┼──┼──┼──┤
 	// ├──┼──┼──┼──┼──┼──┼──┼──┼──┼──╂──┼──┤├──┼──┼──┼──┾━━┿━━┿━━┽──┼──┼──┼──┼──┤
 }
+
+func ExampleIncrementFormatter_Format() {
+	f, err := timechart.NewIncrementFormatter(timechart.NewUnicodeChar, time.Hour)
+	if err != nil {
+		panic(err)
+	}
+	ss := []timechart.Schedule{
+		{
+			timechart.NewTime(9, 0, 0),
+			timechart.NewTime(11, 0, 0),
+		},
+	}
+	fmt.Println(f.Format(ss))
+
+	// Output: ├─┼─┼─┼─┼─┼─┼─┼─┼─┾━┿━┽─┤├─┼─┼─┼─┼─┼─┼─┼─┼─┼─┼─┼─┤
+}
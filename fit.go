@@ -0,0 +1,52 @@
+package timechart
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrTooNarrow is returned when no chart of a day fits in a width.
+var ErrTooNarrow = errors.New("timechart: width too narrow")
+
+// fitResolutions are the resolutions NewFittedFormatter tries, from the finest.
+var fitResolutions = []time.Duration{
+	5 * time.Minute,
+	10 * time.Minute,
+	15 * time.Minute,
+	20 * time.Minute,
+	30 * time.Minute,
+	time.Hour,
+	2 * time.Hour,
+	3 * time.Hour,
+	4 * time.Hour,
+	6 * time.Hour,
+	8 * time.Hour,
+	12 * time.Hour,
+	24 * time.Hour,
+}
+
+// NewFittedFormatter returns an IncrementFormatter of which a chart is at most width columns long,
+// drawn in the finest resolution which fits with the splits of opts, or noon by default.
+// Only if none fits, the day is drawn in a single segment in the finest resolution which fits then.
+// Slots are drawn partly filled as WithPartialSlots does, so schedules shorter than a slot don't vanish.
+// A day of 25 hours drawn as WithLocation does may exceed width by the columns of an hour.
+// It returns ErrTooNarrow if even a single slot of the whole day doesn't fit,
+// and ErrInvalidWindow as NewIncrementFormatter does.
+func NewFittedFormatter(charset func() Char, width int, opts ...Option) (IncrementFormatter, error) {
+	opts = append([]Option{WithPartialSlots()}, opts...)
+	for _, split := range []bool{true, false} {
+		for _, resolution := range fitResolutions {
+			f := newIncrementFormatter(charset, resolution, opts)
+			if f.err != nil {
+				return IncrementFormatter{}, f.err
+			}
+			if !split {
+				WithSplits()(&f)
+			}
+			if len(f.layout()) <= width {
+				return f, nil
+			}
+		}
+	}
+	return IncrementFormatter{}, ErrTooNarrow
+}
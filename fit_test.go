@@ -0,0 +1,80 @@
+package timechart
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewFittedFormatter(t *testing.T) {
+	schedules := []Schedule{
+		{newTime(9, 0), newTime(12, 0)},
+		{newTime(13, 10), newTime(13, 20)},
+	}
+	cases := []struct {
+		width    int
+		opts     []Option
+		expected string
+		err      error
+	}{
+		{
+			width: 2,
+			err:   ErrTooNarrow,
+		},
+		{
+			width:    10,
+			expected: "├─┼╺┥├╍┼─┤",
+		},
+		{
+			width:    40,
+			expected: "├─┼─┼─┼─┼╺┿━┥├╍┼─┼─┼─┼─┼─┤",
+		},
+		{
+			width:    49,
+			expected: "├─┼─┼─┼─┼╺┿━┥├╍┼─┼─┼─┼─┼─┤",
+		},
+		{
+			width:    73,
+			expected: "├─┼─┼─┼─┼─┼─┼─┼─┼─┾━┿━┿━┥├─┼╍┼─┼─┼─┼─┼─┼─┼─┼─┼─┼─┤",
+		},
+		{
+			width:    73,
+			opts:     []Option{WithSplits(7*time.Hour, 15*time.Hour, 23*time.Hour)},
+			expected: "├─┼─┼─┼─┼─┼─┼─┤├─┼─┾━┿━┿━┽─┼╍┼─┤├─┼─┼─┼─┼─┼─┼─┼─┤├─┤",
+		},
+		{
+			width:    80,
+			expected: "├──┼──┼──┼──┼──┼──┼──┼──┼──┾━━┿━━┿━━┥├──┼╍─┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤",
+		},
+		{
+			width:    120,
+			expected: "├───┼───┼───┼───┼───┼───┼───┼───┼───┾━━━┿━━━┿━━━┥├───┼╺──┼───┼───┼───┼───┼───┼───┼───┼───┼───┼───┤",
+		},
+		{
+			width:    40,
+			opts:     []Option{WithWindow(8*time.Hour, 20*time.Hour)},
+			expected: "├──┾━━┿━━┿━━┥├──┼╍─┼──┼──┼──┼──┼──┼──┤",
+		},
+		{
+			width: 40,
+			opts:  []Option{WithWindow(20*time.Hour, 8*time.Hour)},
+			err:   ErrInvalidWindow,
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(fmt.Sprintf("%d columns", tc.width), func(t *testing.T) {
+			f, err := NewFittedFormatter(NewUnicodeChar, tc.width, tc.opts...)
+			assert.Equal(t, tc.err, err)
+			if err != nil {
+				return
+			}
+
+			got := f.Format(schedules)
+			assert.Equal(t, tc.expected, got)
+			assert.LessOrEqual(t, len([]rune(got)), tc.width)
+		})
+	}
+}
@@ -1,103 +1,293 @@
 package timechart
 
 import (
+	"errors"
 	"time"
 )
 
+// ErrInvalidResolution is returned when a resolution can't divide a day into slots.
+var ErrInvalidResolution = errors.New("timechart: invalid resolution")
+
+// ErrInvalidWindow is returned when a window given by WithWindow doesn't lie within a day.
+var ErrInvalidWindow = errors.New("timechart: invalid window")
+
 type Formatter interface {
 	Format([]Schedule) string
 	FormatNow([]Schedule) string
 	FormatWithTime([]Schedule, time.Time) string
 }
 
-type HalfHourIncrementFormatter struct {
-	fn func() Char
+// CheckedFormatter is a Formatter which rejects invalid schedules instead of drawing them.
+type CheckedFormatter interface {
+	Formatter
+
+	FormatChecked([]Schedule) (string, error)
+	FormatWithTimeChecked([]Schedule, time.Time) (string, error)
+}
+
+// IncrementFormatter draws a day, or a window of it, as slots of a fixed resolution,
+// with hour ticks between them and an edge at noon unless split otherwise.
+type IncrementFormatter struct {
+	fn         func() Char
+	resolution time.Duration
+	splits     []time.Duration // times of day splitting the day into segments
+	from       time.Duration   // the time of day the chart begins at
+	to         time.Duration   // the time of day the chart ends at
+	header     time.Duration
+	loc        *time.Location
+	partial    bool
+	err        error // of an invalid option, returned by NewIncrementFormatter
+}
+
+var _ CheckedFormatter = IncrementFormatter{}
+
+// NewIncrementFormatter returns an IncrementFormatter of which each slot lasts for resolution.
+// resolution must divide an hour, like 5, 10, 15, 20 and 30 minutes,
+// or be a whole number of hours dividing a day, like 1 and 2 hours.
+// It returns ErrInvalidWindow if a window of opts doesn't lie within a day.
+func NewIncrementFormatter(charset func() Char, resolution time.Duration, opts ...Option) (IncrementFormatter, error) {
+	if !validResolution(resolution) {
+		return IncrementFormatter{}, ErrInvalidResolution
+	}
+	f := newIncrementFormatter(charset, resolution, opts)
+	if f.err != nil {
+		return IncrementFormatter{}, f.err
+	}
+	return f, nil
+}
+
+func newIncrementFormatter(charset func() Char, resolution time.Duration, opts []Option) IncrementFormatter {
+	f := IncrementFormatter{
+		fn:         charset,
+		resolution: resolution,
+		splits:     []time.Duration{12 * time.Hour},
+		to:         24 * time.Hour,
+	}
+	for _, opt := range opts {
+		opt(&f)
+	}
+	return f
 }
 
-func NewHalfHourIncrementFormatter(charset func() Char) HalfHourIncrementFormatter {
-	return HalfHourIncrementFormatter{fn: charset}
+func validResolution(r time.Duration) bool {
+	switch {
+	case r < time.Minute || r%time.Minute != 0:
+		return false
+	case r <= time.Hour:
+		return time.Hour%r == 0
+	default:
+		return r%time.Hour == 0 && (24*time.Hour)%r == 0
+	}
 }
 
-func (f HalfHourIncrementFormatter) Format(ss []Schedule) string {
-	return f.fill(ss).String()
+func (f IncrementFormatter) Format(ss []Schedule) string {
+	return f.FormatCategories(categorize(ss))
 }
 
-func (f HalfHourIncrementFormatter) FormatNow(ss []Schedule) string {
+func (f IncrementFormatter) FormatNow(ss []Schedule) string {
 	return f.FormatWithTime(ss, time.Now())
 }
 
-func (f HalfHourIncrementFormatter) FormatWithTime(ss []Schedule, t time.Time) string {
-	base := f.fill(ss)
-	i := f.timeToIndex(t.Round(time.Hour))
-	if i > 0 {
-		i -= 1
+func (f IncrementFormatter) FormatWithTime(ss []Schedule, t time.Time) string {
+	return f.FormatCategoriesWithTime(categorize(ss), t)
+}
+
+// FormatCategories is like Format but fills each schedule by its category.
+func (f IncrementFormatter) FormatCategories(cs []CategorizedSchedule) string {
+	day := f.day(cs, nil)
+	return f.withHeader(f.dayLayout(day), f.fill(cs, day).String())
+}
+
+// FormatCategoriesWithTime is like FormatWithTime but fills each schedule by its category.
+func (f IncrementFormatter) FormatCategoriesWithTime(cs []CategorizedSchedule, t time.Time) string {
+	day := f.day(cs, &t)
+	l := f.dayLayout(day)
+	base := f.fill(cs, day)
+	if now := f.at(t, day); l.contains(now) {
+		i := l.nowIndex(now)
+		base[i] = base[i].Now()
 	}
-	if t.Hour() == 12 && t.Minute() > 0 {
-		i += 1
+	return f.withHeader(l, base.String())
+}
+
+// withHeader puts the header of l above chart if f has one.
+func (f IncrementFormatter) withHeader(l layout, chart string) string {
+	if f.header == 0 {
+		return chart
 	}
-	base[i] = base[i].Now()
-	return base.String()
+	return l.header(f.header) + "\n" + chart
 }
 
-func (f HalfHourIncrementFormatter) fill(ss []Schedule) Chars {
-	base := f.empty()
-	if len(ss) == 0 {
-		return base
+// FormatChecked is like Format but returns a *ScheduleError if any of ss is invalid.
+func (f IncrementFormatter) FormatChecked(ss []Schedule) (string, error) {
+	if err := ValidateSchedules(ss); err != nil {
+		return "", err
 	}
+	return f.Format(ss), nil
+}
 
-	for _, schedule := range OverlapSchedules(ss) {
-		s, e := f.pickRange(schedule.Start, schedule.End)
-		for i, c := range base[s:e] {
-			if i == 0 {
-				c = c.Start()
-			}
-			if i == len(base[s:e])-1 {
-				c = c.End()
+// FormatWithTimeChecked is like FormatWithTime but returns a *ScheduleError if any of ss is invalid.
+func (f IncrementFormatter) FormatWithTimeChecked(ss []Schedule, t time.Time) (string, error) {
+	if err := ValidateSchedules(ss); err != nil {
+		return "", err
+	}
+	return f.FormatWithTime(ss, t), nil
+}
+
+// fill draws cs on day, as returned by f.day.
+func (f IncrementFormatter) fill(cs []CategorizedSchedule, day time.Time) Chars {
+	moved := make([]CategorizedSchedule, len(cs))
+	for i, c := range cs {
+		s := NewSchedule(f.in(c.Start), f.in(c.End))
+		moved[i] = CategorizedSchedule{Schedule: onDay(s, day), Category: c.Category}
+	}
+	l := f.dayLayout(day)
+	return f.crop(l, l.fill(f.fn, moved, f.partial), moved)
+}
+
+// crop marks the edges of the window of f which schedules of cs, drawn on l, go on beyond.
+// The edges of the whole day are left as they are.
+func (f IncrementFormatter) crop(l layout, base Chars, cs []CategorizedSchedule) Chars {
+	return l.crop(base, cs, f.from > 0, f.to < 24*time.Hour)
+}
+
+// layout returns the cells of the day of NewTime drawn by f.
+func (f IncrementFormatter) layout() layout {
+	return f.dayLayout(NewTime(0, 0, 0))
+}
+
+// day returns the midnight of the day f draws cs on, marking now if given.
+// It's the day of NewTime lasting for 24 hours, on which schedules are drawn by the wall clock,
+// unless f has a location in which the day of now, or else of the first schedule of instants,
+// lasts for 23 or 25 hours by a daylight saving time transition. Then that day is drawn as long as it lasts.
+func (f IncrementFormatter) day(cs []CategorizedSchedule, now *time.Time) time.Time {
+	clockDay := NewTime(0, 0, 0)
+	if f.loc == nil {
+		return clockDay
+	}
+	t := clockDay
+	if now != nil {
+		t = *now
+	} else {
+		for _, c := range cs {
+			if !isTimeOfDay(c.Start) {
+				t = c.Start
+				break
 			}
-			c = c.Fill()
-			base[s+i] = c
 		}
 	}
-	return base
+	if isTimeOfDay(t) {
+		return clockDay
+	}
+
+	y, m, d := t.In(f.loc).Date()
+	midnight := time.Date(y, m, d, 0, 0, 0, 0, f.loc)
+	if midnight.AddDate(0, 0, 1).Sub(midnight) == 24*time.Hour {
+		return clockDay
+	}
+	return midnight
+}
+
+// at returns where t is marked on day, as returned by f.day.
+func (f IncrementFormatter) at(t, day time.Time) time.Time {
+	if isTimeOfDay(day) {
+		return clock(f.in(t))
+	}
+	return f.in(t)
+}
+
+// dayLayout returns the cells of the window of the given day,
+// split at the splits of f by the wall clock.
+func (f IncrementFormatter) dayLayout(day time.Time) layout {
+	y, m, d := day.Date()
+	at := func(offset time.Duration) time.Time {
+		return time.Date(y, m, d, 0, 0, 0, int(offset), day.Location())
+	}
+	bounds := []time.Time{at(f.from)}
+	for _, split := range f.splits {
+		if split > f.from && split < f.to {
+			bounds = append(bounds, at(split))
+		}
+	}
+	bounds = append(bounds, at(f.to))
+	return newLayout(at(0), bounds, f.resolution)
 }
 
-func (f HalfHourIncrementFormatter) empty() Chars {
-	start := f.fn().Start().Edge()
-	slot := f.fn().Slot()
-	hour := f.fn().Hour()
-	end := f.fn().End().Edge()
+func (f IncrementFormatter) empty() Chars {
+	return f.layout().chars(f.fn)
+}
+
+func (f IncrementFormatter) pickRange(start, end time.Time) (int, int) {
+	l := f.layout()
+	s := clockSchedule(NewSchedule(start, end))
+	return l.startIndex(s.Start), l.endIndex(s.End)
+}
 
-	cc := append(
-		Chars{start},
-		Chars{slot, slot, hour}.Repeat(11)..., // 11 hours
-	)
-	cc = append(cc, Chars{slot, slot, end}...) // 12 o'clock
-	return cc.Repeat(2)                        // am + pm
+// timeToIndex returns the length of the chart drawn until t, which must be on the day of f.layout.
+func (f IncrementFormatter) timeToIndex(t time.Time) int {
+	return f.layout().endIndex(t)
 }
 
-func (f HalfHourIncrementFormatter) pickRange(start, end time.Time) (int, int) {
-	startIdx, endIdx := f.timeToIndex(start), f.timeToIndex(end)
-	if start.Hour()%12 != 0 && start.Minute() == 0 {
-		startIdx -= 1
+// in returns t in the location of f, if any. A time of day made by NewTime has no date
+// to be converted with, so it is returned as it is.
+func (f IncrementFormatter) in(t time.Time) time.Time {
+	if f.loc == nil || isTimeOfDay(t) {
+		return t
 	}
+	return t.In(f.loc)
+}
 
-	return startIdx, endIdx
+// clock returns the time of day of t on the day drawn by IncrementFormatter.
+func clock(t time.Time) time.Time {
+	return time.Date(1, 1, 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
 }
 
-func (f HalfHourIncrementFormatter) timeToIndex(t time.Time) int {
-	if t.Day() > 1 {
-		return len(f.empty())
+// clockSchedule moves s onto the day drawn by IncrementFormatter by the wall clock of its ends.
+// e.g. 22:00-02:00 of the next day becomes 22:00-26:00, of which the chart draws 22:00-24:00.
+func clockSchedule(s Schedule) Schedule {
+	return NewSchedule(clock(s.Start), clock(s.End).AddDate(0, 0, daysBetween(s.Start, s.End)))
+}
+
+// onDay moves s onto day by whole days, keeping the wall clock of its ends.
+// On the day of NewTime, s is drawn by the wall clock as clockSchedule does.
+// On another day, s is drawn where it lies if it starts on day, so 01:30 EDT-01:30 EST lasts for an hour,
+// and times of day made by NewTime are in the location of day.
+func onDay(s Schedule, day time.Time) Schedule {
+	if isTimeOfDay(day) {
+		return clockSchedule(s)
 	}
+	days := daysBetween(s.Start, day)
+	return NewSchedule(moveDays(s.Start, days, day.Location()), moveDays(s.End, days, day.Location()))
+}
 
-	d := t.Sub(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location()))
-	if d == 0 {
-		return 0
+// moveDays returns t moved by days, keeping its wall clock, in loc if t is a time of day.
+func moveDays(t time.Time, days int, loc *time.Location) time.Time {
+	if !isTimeOfDay(t) {
+		if days == 0 {
+			return t
+		}
+		loc = t.Location()
 	}
-	iter := int(d / (30 * time.Minute)) // number of slots
-	iter += iter / 2                    // number of hours
-	iter += 1                           // 00:00s
-	if d > 12*time.Hour {
-		iter += 1 // 12:00
+	y, m, d := t.Date()
+	return time.Date(y, m, d+days, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+}
+
+// daysBetween returns the number of calendar days from the date of t1 to the date of t2.
+func daysBetween(t1, t2 time.Time) int {
+	y1, m1, d1 := t1.Date()
+	y2, m2, d2 := t2.Date()
+	// in seconds rather than a time.Duration, which overflows between a time of day and a date
+	d := time.Date(y2, m2, d2, 0, 0, 0, 0, time.UTC).Unix() - time.Date(y1, m1, d1, 0, 0, 0, 0, time.UTC).Unix()
+	return int(d / (24 * 60 * 60))
+}
+
+// HalfHourIncrementFormatter is an IncrementFormatter of which each slot lasts for 30 minutes.
+type HalfHourIncrementFormatter struct {
+	IncrementFormatter
+}
+
+func NewHalfHourIncrementFormatter(charset func() Char, opts ...Option) HalfHourIncrementFormatter {
+	return HalfHourIncrementFormatter{
+		newIncrementFormatter(charset, 30*time.Minute, opts),
 	}
-	return iter
 }
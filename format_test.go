@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 	"time"
+	_ "time/tzdata"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -64,6 +65,13 @@ func TestHalfHourIncrementFormatter_Format(t *testing.T) {
 			},
 			expected: "├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤┝━━┿━━┿━━┿━━┿━━┿━━┿━━┽──┼──┼──┼──┼──┤",
 		},
+		{
+			name: "across midnight",
+			schedules: []Schedule{
+				{time.Date(2022, 2, 5, 22, 0, 0, 0, time.UTC), time.Date(2022, 2, 6, 2, 0, 0, 0, time.UTC)},
+			},
+			expected: "├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┾━━┿━━┥",
+		},
 		{
 			name: "both sides of noon",
 			schedules: []Schedule{
@@ -83,6 +91,89 @@ func TestHalfHourIncrementFormatter_Format(t *testing.T) {
 	}
 }
 
+func TestNewIncrementFormatter(t *testing.T) {
+	cases := []struct {
+		resolution time.Duration
+		expected   error
+	}{
+		{resolution: 5 * time.Minute},
+		{resolution: 20 * time.Minute},
+		{resolution: 2 * time.Hour},
+		{resolution: 0, expected: ErrInvalidResolution},
+		{resolution: 30 * time.Second, expected: ErrInvalidResolution},
+		{resolution: 25 * time.Minute, expected: ErrInvalidResolution},
+		{resolution: 5 * time.Hour, expected: ErrInvalidResolution},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.resolution.String(), func(t *testing.T) {
+			_, err := NewIncrementFormatter(NewUnicodeChar, tc.resolution)
+			assert.ErrorIs(t, err, tc.expected)
+		})
+	}
+}
+
+func TestNewIncrementFormatter_window(t *testing.T) {
+	cases := []struct {
+		name     string
+		from     time.Duration
+		to       time.Duration
+		expected error
+	}{
+		{name: "working hours", from: 8 * time.Hour, to: 20 * time.Hour},
+		{name: "whole day", from: 0, to: 24 * time.Hour},
+		{name: "over midnight", from: 20 * time.Hour, to: 8 * time.Hour, expected: ErrInvalidWindow},
+		{name: "beyond a day", from: 20 * time.Hour, to: 26 * time.Hour, expected: ErrInvalidWindow},
+		{name: "before a day", from: -time.Hour, to: 8 * time.Hour, expected: ErrInvalidWindow},
+		{name: "empty", from: 10 * time.Hour, to: 10 * time.Hour, expected: ErrInvalidWindow},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewIncrementFormatter(NewUnicodeChar, 30*time.Minute, WithWindow(tc.from, tc.to))
+			assert.ErrorIs(t, err, tc.expected)
+		})
+	}
+}
+
+func TestIncrementFormatter_Format(t *testing.T) {
+	schedules := []Schedule{
+		{newTime(9, 15), newTime(10, 45)},
+		{newTime(16, 0), newTime(19, 0)},
+	}
+	cases := []struct {
+		resolution time.Duration
+		expected   string
+	}{
+		{
+			resolution: 15 * time.Minute,
+			expected:   "├────┼────┼────┼────┼────┼────┼────┼────┼────┼─━━━┿━━━─┼────┤├────┼────┼────┼────┾━━━━┿━━━━┿━━━━┽────┼────┼────┼────┼────┤",
+		},
+		{
+			resolution: 30 * time.Minute,
+			expected:   "├──┼──┼──┼──┼──┼──┼──┼──┼──┼━━┿━─┼──┤├──┼──┼──┼──┾━━┿━━┿━━┽──┼──┼──┼──┼──┤",
+		},
+		{
+			resolution: time.Hour,
+			expected:   "├─┼─┼─┼─┼─┼─┼─┼─┼─┼━┽─┼─┤├─┼─┼─┼─┾━┿━┿━┽─┼─┼─┼─┼─┤",
+		},
+		{
+			resolution: 2 * time.Hour,
+			expected:   "├─┼─┼─┼─┼━┽─┤├─┼─┾━┽─┼─┼─┤",
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.resolution.String(), func(t *testing.T) {
+			f, err := NewIncrementFormatter(NewUnicodeChar, tc.resolution)
+			assert.NoError(t, err)
+
+			got := f.Format(schedules)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
 func TestHalfHourIncrementFormatter_FormatWithTime(t *testing.T) {
 	cases := []struct {
 		name      string
@@ -100,13 +191,13 @@ func TestHalfHourIncrementFormatter_FormatWithTime(t *testing.T) {
 			expected: "├─━┿━━┿━━┿━━┿━━╋━─┼──┼──┼──┼──┼──┼──┤├─━┿━━┿━━┿━━┿━━┿━─┼──┼──┼──┼──┼──┼──┤",
 		},
 		{
-			name: "truncated if t is half",
+			name: "t is half",
 			schedules: []Schedule{
 				{newTime(0, 30), newTime(5, 30)},
 				{newTime(12, 30), newTime(17, 30)},
 			},
 			t:        time.Date(1970, 1, 1, 5, 30, 0, 0, time.UTC),
-			expected: "├─━┿━━┿━━┿━━┿━━┿━─╂──┼──┼──┼──┼──┼──┤├─━┿━━┿━━┿━━┿━━┿━─┼──┼──┼──┼──┼──┼──┤",
+			expected: "├─━┿━━┿━━┿━━┿━━┿━┃┼──┼──┼──┼──┼──┼──┤├─━┿━━┿━━┿━━┿━━┿━─┼──┼──┼──┼──┼──┼──┤",
 		},
 		{
 			name: "00:00",
@@ -124,7 +215,7 @@ func TestHalfHourIncrementFormatter_FormatWithTime(t *testing.T) {
 				{newTime(12, 30), newTime(17, 30)},
 			},
 			t:        time.Date(1970, 1, 1, 9, 30, 0, 0, time.UTC),
-			expected: "├─━┿━━┿━━┿━━┿━━┿━─┼──┼──┼──┼──╂──┼──┤├─━┿━━┿━━┿━━┿━━┿━─┼──┼──┼──┼──┼──┼──┤",
+			expected: "├─━┿━━┿━━┿━━┿━━┿━─┼──┼──┼──┼─┃┼──┼──┤├─━┿━━┿━━┿━━┿━━┿━─┼──┼──┼──┼──┼──┼──┤",
 		},
 		{
 			name: "12:00",
@@ -133,7 +224,7 @@ func TestHalfHourIncrementFormatter_FormatWithTime(t *testing.T) {
 				{newTime(12, 30), newTime(17, 30)},
 			},
 			t:        time.Date(1970, 1, 1, 12, 0, 0, 0, time.UTC),
-			expected: "├─━┿━━┿━━┿━━┿━━┿━─┼──┼──┼──┼──┼──┼──┨├─━┿━━┿━━┿━━┿━━┿━─┼──┼──┼──┼──┼──┼──┤",
+			expected: "├─━┿━━┿━━┿━━┿━━┿━─┼──┼──┼──┼──┼──┼──┤┠─━┿━━┿━━┿━━┿━━┿━─┼──┼──┼──┼──┼──┼──┤",
 		},
 		{
 			name: "12:01",
@@ -142,7 +233,16 @@ func TestHalfHourIncrementFormatter_FormatWithTime(t *testing.T) {
 				{newTime(12, 30), newTime(17, 30)},
 			},
 			t:        time.Date(1970, 1, 1, 12, 1, 0, 0, time.UTC),
-			expected: "├─━┿━━┿━━┿━━┿━━┿━─┼──┼──┼──┼──┼──┼──┤┠─━┿━━┿━━┿━━┿━━┿━─┼──┼──┼──┼──┼──┼──┤",
+			expected: "├─━┿━━┿━━┿━━┿━━┿━─┼──┼──┼──┼──┼──┼──┤├┃━┿━━┿━━┿━━┿━━┿━─┼──┼──┼──┼──┼──┼──┤",
+		},
+		{
+			name: "in a filled slot",
+			schedules: []Schedule{
+				{newTime(0, 30), newTime(5, 30)},
+				{newTime(12, 30), newTime(17, 30)},
+			},
+			t:        time.Date(1970, 1, 1, 13, 20, 0, 0, time.UTC),
+			expected: "├─━┿━━┿━━┿━━┿━━┿━─┼──┼──┼──┼──┼──┼──┤├─━┿╋━┿━━┿━━┿━━┿━─┼──┼──┼──┼──┼──┼──┤",
 		},
 		{
 			name: "13:00",
@@ -176,16 +276,24 @@ func TestHalfHourIncrementFormatter_FormatWithTime(t *testing.T) {
 				{newTime(12, 30), newTime(17, 30)},
 			},
 			t:        time.Date(1970, 1, 1, 23, 59, 0, 0, time.UTC),
-			expected: "├─━┿━━┿━━┿━━┿━━┿━─┼──┼──┼──┼──┼──┼──┤├─━┿━━┿━━┿━━┿━━┿━─┼──┼──┼──┼──┼──┼──┨",
+			expected: "├─━┿━━┿━━┿━━┿━━┿━─┼──┼──┼──┼──┼──┼──┤├─━┿━━┿━━┿━━┿━━┿━─┼──┼──┼──┼──┼──┼─┃┤",
 		},
 		{
-			name: "24:00",
+			name: "00:00 of the next day",
 			schedules: []Schedule{
 				{newTime(0, 30), newTime(5, 30)},
 				{newTime(12, 30), newTime(17, 30)},
 			},
 			t:        time.Date(1970, 1, 2, 0, 0, 0, 0, time.UTC),
-			expected: "├─━┿━━┿━━┿━━┿━━┿━─┼──┼──┼──┼──┼──┼──┤├─━┿━━┿━━┿━━┿━━┿━─┼──┼──┼──┼──┼──┼──┨",
+			expected: "┠─━┿━━┿━━┿━━┿━━┿━─┼──┼──┼──┼──┼──┼──┤├─━┿━━┿━━┿━━┿━━┿━─┼──┼──┼──┼──┼──┼──┤",
+		},
+		{
+			name: "calendar date",
+			schedules: []Schedule{
+				{time.Date(2022, 2, 5, 16, 0, 0, 0, time.UTC), time.Date(2022, 2, 5, 19, 0, 0, 0, time.UTC)},
+			},
+			t:        time.Date(2022, 2, 5, 19, 0, 0, 0, time.UTC),
+			expected: "├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤├──┼──┼──┼──┾━━┿━━┿━━╉──┼──┼──┼──┼──┤",
 		},
 	}
 	for _, tc := range cases {
@@ -199,6 +307,440 @@ func TestHalfHourIncrementFormatter_FormatWithTime(t *testing.T) {
 	}
 }
 
+func TestIncrementFormatter_FormatChecked(t *testing.T) {
+	f := NewHalfHourIncrementFormatter(NewUnicodeChar)
+	schedules := []Schedule{
+		{newTime(16, 0), newTime(19, 0)},
+		{newTime(12, 0), newTime(10, 0)},
+	}
+
+	got, err := f.FormatChecked(schedules)
+	assert.ErrorIs(t, err, ErrEndBeforeStart)
+	assert.Empty(t, got)
+
+	got, err = f.FormatWithTimeChecked(schedules, time.Date(1970, 1, 1, 16, 0, 0, 0, time.UTC))
+	assert.ErrorIs(t, err, ErrEndBeforeStart)
+	assert.Empty(t, got)
+
+	got, err = f.FormatChecked(schedules[:1])
+	assert.NoError(t, err)
+	assert.Equal(t, "├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤├──┼──┼──┼──┾━━┿━━┿━━┽──┼──┼──┼──┼──┤", got)
+
+	assert.NotPanics(t, func() {
+		f.Format(schedules[1:])
+	})
+}
+
+func TestIncrementFormatter_FormatCategories(t *testing.T) {
+	cases := []struct {
+		name       string
+		charset    func() Char
+		categories []CategorizedSchedule
+		expected   string
+	}{
+		{
+			name:    "unicode",
+			charset: NewUnicodeChar,
+			categories: []CategorizedSchedule{
+				{Schedule{newTime(9, 0), newTime(12, 0)}, Busy},
+				{Schedule{newTime(11, 0), newTime(15, 0)}, Tentative},
+				{Schedule{newTime(14, 0), newTime(18, 0)}, OutOfOffice},
+			},
+			expected: "├──┼──┼──┼──┼──┼──┼──┼──┼──┾━━┿━━┿━━┥┝┅┅┿┅┅┿══┿══┿══┿══┽──┼──┼──┼──┼──┼──┤",
+		},
+		{
+			name:    "ascii",
+			charset: NewASCIIChar,
+			categories: []CategorizedSchedule{
+				{Schedule{newTime(9, 0), newTime(12, 0)}, Busy},
+				{Schedule{newTime(11, 0), newTime(15, 0)}, Tentative},
+				{Schedule{newTime(14, 0), newTime(18, 0)}, OutOfOffice},
+			},
+			expected: "|--+--+--+--+--+--+--+--+--#==#==#==][~~#~~#xx#xx#xx#xx#--+--+--+--+--+--|",
+		},
+		{
+			name:    "precedence is order insensitive",
+			charset: NewASCIIChar,
+			categories: []CategorizedSchedule{
+				{Schedule{newTime(2, 0), newTime(4, 0)}, OutOfOffice},
+				{Schedule{newTime(1, 0), newTime(5, 0)}, Busy},
+				{Schedule{newTime(0, 0), newTime(6, 0)}, Tentative},
+			},
+			expected: "[~~#==#xx#xx#==#~~#--+--+--+--+--+--||--+--+--+--+--+--+--+--+--+--+--+--|",
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			f := NewHalfHourIncrementFormatter(tc.charset)
+
+			got := f.FormatCategories(tc.categories)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestWithHeader(t *testing.T) {
+	schedules := []Schedule{
+		{newTime(9, 0), newTime(17, 0)},
+	}
+	cases := []struct {
+		resolution time.Duration
+		every      time.Duration
+		expected   string
+	}{
+		{
+			resolution: 30 * time.Minute,
+			every:      3 * time.Hour,
+			expected: "0        3        6        9        12        15       18       21\n" +
+				"├──┼──┼──┼──┼──┼──┼──┼──┼──┾━━┿━━┿━━┥┝━━┿━━┿━━┿━━┿━━┽──┼──┼──┼──┼──┼──┼──┤",
+		},
+		{
+			resolution: 30 * time.Minute,
+			every:      time.Hour,
+			expected: "0  1  2  3  4  5  6  7  8  9  10 11 12  13 14 15 16 17 18 19 20 21 22 23\n" +
+				"├──┼──┼──┼──┼──┼──┼──┼──┼──┾━━┿━━┿━━┥┝━━┿━━┿━━┿━━┿━━┽──┼──┼──┼──┼──┼──┼──┤",
+		},
+		{
+			resolution: time.Hour,
+			every:      time.Hour,
+			expected: "0 1 2 3 4 5 6 7 8 9 10  12 13  15  17  19  21  23\n" +
+				"├─┼─┼─┼─┼─┼─┼─┼─┼─┾━┿━┿━┥┝━┿━┿━┿━┿━┽─┼─┼─┼─┼─┼─┼─┤",
+		},
+		{
+			resolution: 30 * time.Minute,
+			every:      3*time.Hour + 30*time.Minute,
+			expected: "0        3        6        9        12        15       18       21\n" +
+				"├──┼──┼──┼──┼──┼──┼──┼──┼──┾━━┿━━┿━━┥┝━━┿━━┿━━┿━━┿━━┽──┼──┼──┼──┼──┼──┼──┤",
+		},
+		{
+			resolution: 2 * time.Hour,
+			every:      6 * time.Hour,
+			expected: "0     6     12     18\n" +
+				"├─┼─┼─┼─┼━┿━┥┝━┿━┽─┼─┼─┼─┤",
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(fmt.Sprintf("%s every %s", tc.resolution, tc.every), func(t *testing.T) {
+			f, err := NewIncrementFormatter(NewUnicodeChar, tc.resolution, WithHeader(tc.every))
+			assert.NoError(t, err)
+
+			got := f.Format(schedules)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestWithLocation(t *testing.T) {
+	seoul, err := time.LoadLocation("Asia/Seoul")
+	assert.NoError(t, err)
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+
+	cases := []struct {
+		name      string
+		schedules []Schedule
+		t         time.Time
+		expected  string
+	}{
+		{
+			name: "instants",
+			schedules: []Schedule{
+				{time.Date(2022, 2, 1, 2, 0, 0, 0, time.UTC), time.Date(2022, 2, 1, 4, 0, 0, 0, time.UTC)},
+			},
+			t:        time.Date(2022, 2, 1, 3, 0, 0, 0, time.UTC),
+			expected: "├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┾━━┥┣━━┽──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤",
+		},
+		{
+			name: "different zones",
+			schedules: []Schedule{
+				{time.Date(2022, 2, 1, 8, 0, 0, 0, newYork), time.Date(2022, 2, 1, 10, 0, 0, 0, newYork)},
+				{time.Date(2022, 2, 1, 13, 0, 0, 0, time.UTC), time.Date(2022, 2, 1, 14, 0, 0, 0, time.UTC)},
+			},
+			t:        time.Date(2022, 2, 1, 9, 0, 0, 0, newYork),
+			expected: "├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┾━━╋━━┥",
+		},
+		{
+			name: "times of day",
+			schedules: []Schedule{
+				{newTime(9, 0), newTime(10, 0)},
+			},
+			t:        time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
+			expected: "├──┼──┼──┼──┼──┼──┼──┼──┼──╊━━┽──┼──┤├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤",
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			f := NewHalfHourIncrementFormatter(NewUnicodeChar, WithLocation(seoul))
+
+			got := f.FormatWithTime(tc.schedules, tc.t)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestWithSplits(t *testing.T) {
+	schedules := []Schedule{
+		{newTime(6, 0), newTime(8, 0)},
+		{newTime(14, 30), newTime(15, 30)},
+	}
+	cases := []struct {
+		name        string
+		opt         Option
+		expectedIdx int
+		expected    string
+	}{
+		{
+			name:        "no split",
+			opt:         WithSplits(),
+			expectedIdx: 46,
+			expected:    "├──┼──┼──┼──┼──┼──┾━━┿━━┽──┼──┼──┼──┼──┼──┼─━┿━─┼──┼──┼──┼──┼──┼──┼──┼──┤",
+		},
+		{
+			name:        "every 6 hours",
+			opt:         WithSplitEvery(6 * time.Hour),
+			expectedIdx: 48,
+			expected:    "├──┼──┼──┼──┼──┼──┤┝━━┿━━┽──┼──┼──┼──┤├──┼──┼─━┿━─┼──┼──┤├──┼──┼──┼──┼──┼──┤",
+		},
+		{
+			name:        "every unitless 6",
+			opt:         WithSplitEvery(6),
+			expectedIdx: 47,
+			expected:    "├──┼──┼──┼──┼──┼──┾━━┿━━┽──┼──┼──┼──┤├──┼──┼─━┿━─┼──┼──┼──┼──┼──┼──┼──┼──┤",
+		},
+		{
+			name:        "every less than a slot",
+			opt:         WithSplitEvery(10 * time.Minute),
+			expectedIdx: 47,
+			expected:    "├──┼──┼──┼──┼──┼──┾━━┿━━┽──┼──┼──┼──┤├──┼──┼─━┿━─┼──┼──┼──┼──┼──┼──┼──┼──┤",
+		},
+		{
+			name:        "shift changes",
+			opt:         WithSplits(23*time.Hour, 7*time.Hour, 15*time.Hour),
+			expectedIdx: 47,
+			expected:    "├──┼──┼──┼──┼──┼──┾━━┥┝━━┽──┼──┼──┼──┼──┼──┼─━┥┝━─┼──┼──┼──┼──┼──┼──┼──┤├──┤",
+		},
+		{
+			name:        "off the slots",
+			opt:         WithSplits(10*time.Hour + 15*time.Minute),
+			expectedIdx: 49,
+			expected:    "├──┼──┼──┼──┼──┼──┾━━┿━━┽──┼──┼─┤├──┼──┼──┼──┼─━┿━─┼──┼──┼──┼──┼──┼──┼──┼──┤",
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			f := NewHalfHourIncrementFormatter(NewUnicodeChar, tc.opt)
+
+			assert.Equal(t, tc.expectedIdx, f.timeToIndex(newTime(15, 0)))
+			got := f.Format(schedules)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestWithWindow(t *testing.T) {
+	schedules := []Schedule{
+		{newTime(6, 0), newTime(9, 0)},
+		{newTime(11, 30), newTime(12, 30)},
+		{newTime(19, 0), newTime(23, 0)},
+	}
+	cases := []struct {
+		name     string
+		from     time.Duration
+		to       time.Duration
+		t        time.Time
+		expected string
+	}{
+		{
+			name:     "working hours",
+			from:     8 * time.Hour,
+			to:       20 * time.Hour,
+			t:        time.Date(2022, 2, 1, 10, 20, 0, 0, time.UTC),
+			expected: "╞━━┽──┼┃─┼─━┥┝━─┼──┼──┼──┼──┼──┼──┾━━╡",
+		},
+		{
+			name:     "now out of the window",
+			from:     8 * time.Hour,
+			to:       20 * time.Hour,
+			t:        time.Date(2022, 2, 1, 6, 20, 0, 0, time.UTC),
+			expected: "╞━━┽──┼──┼─━┥┝━─┼──┼──┼──┼──┼──┼──┾━━╡",
+		},
+		{
+			name:     "off the hour",
+			from:     8*time.Hour + 10*time.Minute,
+			to:       24 * time.Hour,
+			t:        time.Date(2022, 2, 1, 6, 20, 0, 0, time.UTC),
+			expected: "╞━━┽──┼──┼─━┥┝━─┼──┼──┼──┼──┼──┼──┾━━┿━━┿━━┿━━┽──┤",
+		},
+		{
+			name:     "within the window",
+			from:     6 * time.Hour,
+			to:       23 * time.Hour,
+			t:        time.Date(2022, 2, 1, 6, 20, 0, 0, time.UTC),
+			expected: "┝╋━┿━━┿━━┽──┼──┼─━┥┝━─┼──┼──┼──┼──┼──┼──┾━━┿━━┿━━┿━━┥",
+		},
+		{
+			name:     "over midnight",
+			from:     20 * time.Hour,
+			to:       8 * time.Hour,
+			t:        time.Date(2022, 2, 1, 6, 20, 0, 0, time.UTC),
+			expected: "├──┼──┼──┼──┼──┼──┾╋━┿━━┿━━┽──┼──┼─━┥┝━─┼──┼──┼──┼──┼──┼──┾━━┿━━┿━━┿━━┽──┤",
+		},
+		{
+			name:     "beyond a day",
+			from:     20 * time.Hour,
+			to:       26 * time.Hour,
+			t:        time.Date(2022, 2, 1, 6, 20, 0, 0, time.UTC),
+			expected: "├──┼──┼──┼──┼──┼──┾╋━┿━━┿━━┽──┼──┼─━┥┝━─┼──┼──┼──┼──┼──┼──┾━━┿━━┿━━┿━━┽──┤",
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			f := NewHalfHourIncrementFormatter(NewUnicodeChar, WithWindow(tc.from, tc.to))
+
+			got := f.FormatWithTime(schedules, tc.t)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestWithPartialSlots(t *testing.T) {
+	cases := []struct {
+		name      string
+		schedules []Schedule
+		expected  string
+	}{
+		{
+			name: "within a slot",
+			schedules: []Schedule{
+				{newTime(10, 10), newTime(10, 20)},
+			},
+			expected: "├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼╍─┼──┤├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤",
+		},
+		{
+			name: "head of a slot",
+			schedules: []Schedule{
+				{newTime(9, 0), newTime(10, 40)},
+			},
+			expected: "├──┼──┼──┼──┼──┼──┼──┼──┼──┾━━┿━╸┼──┤├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤",
+		},
+		{
+			name: "tails of slots",
+			schedules: []Schedule{
+				{newTime(10, 10), newTime(12, 20)},
+			},
+			expected: "├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼╺━┿━━┥┝╸─┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤",
+		},
+		{
+			name: "both ends of a slot",
+			schedules: []Schedule{
+				{newTime(10, 0), newTime(10, 10)},
+				{newTime(10, 20), newTime(10, 30)},
+			},
+			expected: "├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┾╍─┼──┤├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤",
+		},
+		{
+			name: "whole slots",
+			schedules: []Schedule{
+				{newTime(10, 0), newTime(11, 0)},
+			},
+			expected: "├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┾━━┽──┤├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤",
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			f := NewHalfHourIncrementFormatter(NewUnicodeChar, WithPartialSlots())
+
+			got := f.Format(tc.schedules)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestIncrementFormatter_daylightSavingTime(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	assert.NoError(t, err)
+	edt := time.FixedZone("EDT", -4*60*60)
+	est := time.FixedZone("EST", -5*60*60)
+
+	cases := []struct {
+		name      string
+		loc       *time.Location
+		schedules []Schedule
+		t         time.Time
+		expected  string
+	}{
+		{
+			name: "new york, 23 hours",
+			loc:  newYork,
+			schedules: []Schedule{
+				{time.Date(2022, 3, 13, 1, 0, 0, 0, newYork), time.Date(2022, 3, 13, 4, 0, 0, 0, newYork)},
+			},
+			expected: "├──┾━━┿━━┽──┼──┼──┼──┼──┼──┼──┼──┤├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤",
+		},
+		{
+			name: "new york, the repeated hour",
+			loc:  newYork,
+			schedules: []Schedule{
+				{time.Date(2022, 11, 6, 1, 30, 0, 0, edt), time.Date(2022, 11, 6, 1, 30, 0, 0, est)},
+			},
+			expected: "├──┼─━┿━─┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤",
+		},
+		{
+			name: "new york, times of day",
+			loc:  newYork,
+			schedules: []Schedule{
+				{newTime(9, 0), newTime(10, 0)},
+			},
+			t:        time.Date(2022, 11, 6, 1, 30, 0, 0, est),
+			expected: "├──┼──┼─┃┼──┼──┼──┼──┼──┼──┼──┾━━┽──┼──┤├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤",
+		},
+		{
+			name: "berlin, 23 hours",
+			loc:  berlin,
+			schedules: []Schedule{
+				{time.Date(2022, 3, 27, 1, 0, 0, 0, berlin), time.Date(2022, 3, 27, 4, 0, 0, 0, berlin)},
+			},
+			expected: "├──┾━━┿━━┽──┼──┼──┼──┼──┼──┼──┼──┤├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤",
+		},
+		{
+			name: "berlin, 25 hours",
+			loc:  berlin,
+			schedules: []Schedule{
+				{time.Date(2022, 10, 30, 1, 0, 0, 0, berlin), time.Date(2022, 10, 30, 4, 0, 0, 0, berlin)},
+			},
+			expected: "├──┾━━┿━━┿━━┿━━┽──┼──┼──┼──┼──┼──┼──┼──┤├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤",
+		},
+		{
+			name: "other days",
+			loc:  newYork,
+			schedules: []Schedule{
+				{time.Date(2022, 11, 7, 1, 0, 0, 0, newYork), time.Date(2022, 11, 7, 4, 0, 0, 0, newYork)},
+			},
+			expected: "├──┾━━┿━━┿━━┽──┼──┼──┼──┼──┼──┼──┼──┤├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤",
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			f := NewHalfHourIncrementFormatter(NewUnicodeChar, WithLocation(tc.loc))
+
+			got := f.Format(tc.schedules)
+			if !tc.t.IsZero() {
+				got = f.FormatWithTime(tc.schedules, tc.t)
+			}
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
 func TestHalfHourIncrementFormatter_timeToIndex(t *testing.T) {
 	cases := []struct {
 		t           time.Time
@@ -0,0 +1,124 @@
+package timechart
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// Row is a labeled line of a Gantt chart, e.g. schedules of a person.
+type Row struct {
+	Label     string
+	Schedules []Schedule
+}
+
+// RowsFromMap returns a row for each label of m, sorted by the label.
+func RowsFromMap(m map[string][]Schedule) []Row {
+	rows := make([]Row, 0, len(m))
+	for label, ss := range m {
+		rows = append(rows, Row{Label: label, Schedules: ss})
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i].Label < rows[j].Label
+	})
+	return rows
+}
+
+// Gantt draws rows one below another on the axis of its IncrementFormatter,
+// each headed by its label padded to the widest one.
+type Gantt struct {
+	f IncrementFormatter
+}
+
+func NewGantt(f IncrementFormatter) Gantt {
+	return Gantt{f: f}
+}
+
+func (g Gantt) Format(rows []Row) string {
+	day := g.day(rows, nil)
+	return g.join(rows, g.fill(rows, day), day)
+}
+
+func (g Gantt) FormatNow(rows []Row) string {
+	return g.FormatWithTime(rows, time.Now())
+}
+
+// FormatWithTime is like Format but marks t on every row.
+func (g Gantt) FormatWithTime(rows []Row, t time.Time) string {
+	day := g.day(rows, &t)
+	charts := g.fill(rows, day)
+	l := g.f.dayLayout(day)
+	if now := g.f.at(t, day); l.contains(now) {
+		i := l.nowIndex(now)
+		for _, chart := range charts {
+			chart[i] = chart[i].Now()
+		}
+	}
+	return g.join(rows, charts, day)
+}
+
+// day returns the day all rows are drawn on, as IncrementFormatter.day does for their schedules.
+func (g Gantt) day(rows []Row, now *time.Time) time.Time {
+	var ss []Schedule
+	for _, row := range rows {
+		ss = append(ss, row.Schedules...)
+	}
+	return g.f.day(categorize(ss), now)
+}
+
+func (g Gantt) fill(rows []Row, day time.Time) []Chars {
+	charts := make([]Chars, len(rows))
+	for i, row := range rows {
+		charts[i] = g.f.fill(categorize(row.Schedules), day)
+	}
+	return charts
+}
+
+func (g Gantt) join(rows []Row, charts []Chars, day time.Time) string {
+	width := 0
+	for _, row := range rows {
+		if w := displayWidth(row.Label); w > width {
+			width = w
+		}
+	}
+
+	lines := make([]string, 0, len(rows)+1)
+	if g.f.header != 0 {
+		lines = append(lines, strings.Repeat(" ", width+1)+g.f.dayLayout(day).header(g.f.header))
+	}
+	for i, row := range rows {
+		pad := strings.Repeat(" ", width-displayWidth(row.Label)+1)
+		lines = append(lines, row.Label+pad+charts[i].String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// displayWidth returns the number of columns s takes in a terminal,
+// where east asian wide characters like Hangul take two.
+func displayWidth(s string) int {
+	w := 0
+	for _, r := range s {
+		w++
+		if isWide(r) {
+			w++
+		}
+	}
+	return w
+}
+
+func isWide(r rune) bool {
+	switch {
+	case r >= 0x1100 && r <= 0x115F: // Hangul Jamo
+		return true
+	case r >= 0x2E80 && r <= 0xA4CF && r != 0x303F: // CJK, Hiragana, Katakana, …
+		return true
+	case r >= 0xAC00 && r <= 0xD7A3: // Hangul Syllables
+		return true
+	case r >= 0xF900 && r <= 0xFAFF: // CJK Compatibility Ideographs
+		return true
+	case r >= 0xFF00 && r <= 0xFF60, r >= 0xFFE0 && r <= 0xFFE6: // Fullwidth Forms
+		return true
+	default:
+		return false
+	}
+}
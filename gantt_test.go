@@ -0,0 +1,72 @@
+package timechart
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGantt_Format(t *testing.T) {
+	rows := RowsFromMap(map[string][]Schedule{
+		"winter": {
+			{newTime(9, 0), newTime(12, 0)},
+		},
+		"bob": {
+			{newTime(11, 0), newTime(14, 0)},
+			{newTime(16, 0), newTime(17, 30)},
+		},
+		"정겨울": nil,
+	})
+	cases := []struct {
+		name     string
+		f        IncrementFormatter
+		t        time.Time
+		expected string
+	}{
+		{
+			name: "rows",
+			f:    NewHalfHourIncrementFormatter(NewUnicodeChar).IncrementFormatter,
+			expected: "bob    ├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┾━━┥┝━━┿━━┽──┼──┾━━┿━─┼──┼──┼──┼──┼──┼──┤\n" +
+				"winter ├──┼──┼──┼──┼──┼──┼──┼──┼──┾━━┿━━┿━━┥├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤\n" +
+				"정겨울 ├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤",
+		},
+		{
+			name: "now and header",
+			f:    NewHalfHourIncrementFormatter(NewUnicodeChar, WithHeader(6*time.Hour)).IncrementFormatter,
+			t:    time.Date(2022, 2, 1, 11, 0, 0, 0, time.UTC),
+			expected: "       0                 6                 12                 18\n" +
+				"bob    ├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──╊━━┥┝━━┿━━┽──┼──┾━━┿━─┼──┼──┼──┼──┼──┼──┤\n" +
+				"winter ├──┼──┼──┼──┼──┼──┼──┼──┼──┾━━┿━━╋━━┥├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤\n" +
+				"정겨울 ├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──╂──┤├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤",
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewGantt(tc.f)
+
+			got := g.Format(rows)
+			if !tc.t.IsZero() {
+				got = g.FormatWithTime(rows, tc.t)
+			}
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestGantt_daylightSavingTime(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+	edt := time.FixedZone("EDT", -4*60*60)
+	est := time.FixedZone("EST", -5*60*60)
+	g := NewGantt(NewHalfHourIncrementFormatter(NewUnicodeChar, WithHeader(time.Hour), WithLocation(newYork)).IncrementFormatter)
+
+	got := g.Format([]Row{
+		{Label: "on call", Schedules: []Schedule{{time.Date(2022, 11, 6, 1, 30, 0, 0, edt), time.Date(2022, 11, 6, 1, 30, 0, 0, est)}}},
+		{Label: "deploy", Schedules: []Schedule{{time.Date(2022, 11, 6, 1, 0, 0, 0, est), time.Date(2022, 11, 6, 3, 0, 0, 0, newYork)}}},
+	})
+	assert.Equal(t, "        0  1  1  2  3  4  5  6  7  8  9  10 11 12  13 14 15 16 17 18 19 20 21 22 23\n"+
+		"on call ├──┼─━┿━─┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤\n"+
+		"deploy  ├──┼──┾━━┿━━┽──┼──┼──┼──┼──┼──┼──┼──┼──┤├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤", got)
+}
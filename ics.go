@@ -0,0 +1,531 @@
+package timechart
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidICS is returned when an iCalendar stream can't be read.
+var ErrInvalidICS = errors.New("timechart: invalid iCalendar")
+
+// ReadICS reads the events of an iCalendar (RFC 5545) stream as schedules, in the order they appear.
+// An event lasts from its DTSTART until its DTEND, or for its DURATION.
+// Times with a TZID are in that location, UTC ones end with Z and the others are in time.Local.
+// A TZID which isn't a location of the IANA Time Zone database, like "Pacific Standard Time" of Outlook,
+// is read by the offsets of its VTIMEZONE preceding the events, as calendars write them.
+// All-day events given by dates last from the midnight of their first day to the midnight after their last day.
+// Cancelled events are left out, and recurrences are not expanded.
+func ReadICS(r io.Reader) ([]Schedule, error) {
+	var ss []Schedule
+	var event *icsEvent
+	depth := 0 // of components nested in the event, like VALARM
+	zones := map[string]*icsTimezone{}
+	var zone *icsTimezone
+	var rule *icsZoneRule // of the zone
+	l := newICSLines(r)
+	for l.next() {
+		p, err := parseICSProperty(l.text)
+		if err != nil {
+			return nil, l.errorf("%s", err)
+		}
+		switch {
+		case p.name == "BEGIN" && event != nil:
+			depth++
+		case p.name == "BEGIN" && strings.EqualFold(p.value, "VEVENT"):
+			event = &icsEvent{}
+		case p.name == "BEGIN" && strings.EqualFold(p.value, "VTIMEZONE"):
+			zone = &icsTimezone{}
+		case p.name == "BEGIN" && zone != nil:
+			rule = &icsZoneRule{}
+			rule.dst = strings.EqualFold(p.value, "DAYLIGHT")
+		case p.name == "END" && rule != nil:
+			if err := rule.parseStart(); err != nil {
+				return nil, l.errorf("%s: %s", p.value, err)
+			}
+			zone.rules = append(zone.rules, *rule)
+			rule = nil
+		case p.name == "END" && zone != nil:
+			zones[zone.tzid] = zone
+			zone = nil
+		case rule != nil:
+			if err := rule.set(p); err != nil {
+				return nil, l.errorf("%s: %s", p.name, err)
+			}
+		case zone != nil && p.name == "TZID":
+			zone.tzid = p.value
+		case p.name == "END" && event != nil && depth > 0:
+			depth--
+		case p.name == "END" && event != nil:
+			schedule, ok, err := event.schedule()
+			if err != nil {
+				return nil, l.errorf("%s", err)
+			}
+			if ok {
+				ss = append(ss, schedule)
+			}
+			event = nil
+		case event != nil && depth == 0:
+			if err := event.set(p, zones); err != nil {
+				return nil, l.errorf("%s: %s", p.name, err)
+			}
+		}
+	}
+	if err := l.scanner.Err(); err != nil {
+		return nil, err
+	}
+	return ss, nil
+}
+
+// icsLines reads unfolded content lines, where a line beginning with a space or a tab continues the previous one.
+type icsLines struct {
+	scanner *bufio.Scanner
+	text    string
+	line    int // where text starts
+
+	peeked bool
+	peek   string
+	n      int // lines read
+}
+
+func newICSLines(r io.Reader) *icsLines {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<20) // for long lines like inline attachments
+	return &icsLines{scanner: scanner}
+}
+
+func (l *icsLines) next() bool {
+	if !l.peeked && !l.read() {
+		return false
+	}
+	l.text, l.line = l.peek, l.n
+	l.peeked = false
+	for l.read() {
+		if !strings.HasPrefix(l.peek, " ") && !strings.HasPrefix(l.peek, "\t") {
+			l.peeked = true
+			break
+		}
+		l.text += l.peek[1:]
+	}
+	if l.text == "" {
+		return l.next()
+	}
+	return true
+}
+
+func (l *icsLines) read() bool {
+	if !l.scanner.Scan() {
+		return false
+	}
+	l.n++
+	l.peek = strings.TrimRight(l.scanner.Text(), "\r")
+	return true
+}
+
+func (l *icsLines) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: line %d: %s", ErrInvalidICS, l.line, fmt.Sprintf(format, args...))
+}
+
+// icsProperty is a content line like DTSTART;TZID=Asia/Seoul:20220201T090000.
+type icsProperty struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+func parseICSProperty(line string) (icsProperty, error) {
+	p := icsProperty{params: map[string]string{}}
+	quoted := false
+	i := strings.IndexFunc(line, func(r rune) bool {
+		if r == '"' {
+			quoted = !quoted
+		}
+		return r == ':' && !quoted
+	})
+	if i < 0 {
+		return p, fmt.Errorf("missing ':' in %q", line)
+	}
+	p.value = line[i+1:]
+
+	fields := strings.Split(line[:i], ";")
+	p.name = strings.ToUpper(fields[0])
+	for _, field := range fields[1:] {
+		kv := strings.SplitN(field, "=", 2)
+		if len(kv) != 2 {
+			return p, fmt.Errorf("invalid parameter %q", field)
+		}
+		p.params[strings.ToUpper(kv[0])] = strings.Trim(kv[1], `"`)
+	}
+	return p, nil
+}
+
+type icsEvent struct {
+	start     time.Time
+	end       time.Time
+	duration  *icsDuration
+	allDay    bool
+	cancelled bool
+}
+
+func (e *icsEvent) set(p icsProperty, zones map[string]*icsTimezone) error {
+	var err error
+	switch p.name {
+	case "DTSTART":
+		e.start, e.allDay, err = parseICSTime(p, zones)
+	case "DTEND":
+		e.end, _, err = parseICSTime(p, zones)
+	case "DURATION":
+		var d icsDuration
+		d, err = parseICSDuration(p.value)
+		e.duration = &d
+	case "STATUS":
+		e.cancelled = strings.EqualFold(p.value, "CANCELLED")
+	}
+	return err
+}
+
+// schedule returns the schedule of e, or false if e is cancelled.
+func (e *icsEvent) schedule() (Schedule, bool, error) {
+	switch {
+	case e.start.IsZero():
+		return Schedule{}, false, errors.New("event without DTSTART")
+	case e.cancelled:
+		return Schedule{}, false, nil
+	case !e.end.IsZero():
+		return NewSchedule(e.start, e.end), true, nil
+	case e.duration != nil:
+		return NewSchedule(e.start, e.duration.addTo(e.start)), true, nil
+	case e.allDay:
+		return NewSchedule(e.start, e.start.AddDate(0, 0, 1)), true, nil
+	default:
+		return NewSchedule(e.start, e.start), true, nil
+	}
+}
+
+// parseICSTime parses the date or date-time of p, and returns whether it's a date.
+// A TZID is looked up in zones first, as the VTIMEZONE of the file tells its offsets,
+// and read as a location only if the file has none for it.
+// A time in a VTIMEZONE is in the location of its TZID where it has the same offset then.
+func parseICSTime(p icsProperty, zones map[string]*icsTimezone) (time.Time, bool, error) {
+	loc := time.Local
+	var zone *icsTimezone
+	if tzid, ok := p.params["TZID"]; ok {
+		if zone = zones[tzid]; zone != nil {
+			loc = time.UTC // for the wall clock, moved into the zone below
+		} else if l, err := time.LoadLocation(tzid); err == nil {
+			loc = l
+		} else {
+			return time.Time{}, false, fmt.Errorf("unknown TZID %q", tzid)
+		}
+	}
+
+	if strings.EqualFold(p.params["VALUE"], "DATE") || len(p.value) == len("20060102") {
+		t, err := time.ParseInLocation("20060102", p.value, loc)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("invalid date %q", p.value)
+		}
+		if zone != nil {
+			t = zone.in(t)
+		}
+		return t, true, nil
+	}
+	if strings.HasSuffix(p.value, "Z") {
+		loc, zone = time.UTC, nil
+	}
+	t, err := time.ParseInLocation("20060102T150405", strings.TrimSuffix(p.value, "Z"), loc)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("invalid date-time %q", p.value)
+	}
+	if zone != nil {
+		t = zone.in(t)
+	}
+	return t, false, nil
+}
+
+// icsTimezone is a VTIMEZONE, read for a TZID which isn't a location.
+type icsTimezone struct {
+	tzid  string
+	rules []icsZoneRule
+}
+
+// at returns the time at the wall clock of w, read in UTC, in the fixed zone of the offset of z then.
+// Before every observance of z, it's the offset they change from.
+func (z *icsTimezone) at(w time.Time) time.Time {
+	var in *icsZoneRule
+	var since time.Time
+	for i := range z.rules {
+		r := &z.rules[i]
+		for _, onset := range r.onsets(w.Year()) {
+			if timeGT(onset, w.Add(-time.Duration(r.to)*time.Second)) {
+				continue // after w
+			}
+			if in == nil || timeGT(onset, since) {
+				in, since = r, onset
+			}
+		}
+	}
+
+	name, offset := z.tzid, 0
+	switch {
+	case in != nil:
+		offset = in.to
+		if in.abbr != "" {
+			name = in.abbr
+		}
+	case len(z.rules) > 0:
+		first := z.rules[0]
+		for _, r := range z.rules[1:] {
+			if timeLT(r.onset, first.onset) {
+				first = r
+			}
+		}
+		offset = first.from
+	}
+	return w.Add(-time.Duration(offset) * time.Second).In(time.FixedZone(name, offset))
+}
+
+// in returns the time at the wall clock of w, read in UTC, in z.
+// It's in the location named by the TZID of z if that has the offset of z then, or in a fixed zone.
+func (z *icsTimezone) in(w time.Time) time.Time {
+	t := z.at(w)
+	if loc, err := time.LoadLocation(z.tzid); err == nil {
+		_, offset := t.Zone()
+		if _, o := t.In(loc).Zone(); o == offset {
+			return t.In(loc)
+		}
+	}
+	return t
+}
+
+// icsObservance is an offset of a location from a point in time.
+type icsObservance struct {
+	onset time.Time
+	from  int // offset in seconds before onset
+	to    int // offset in seconds from onset
+	abbr  string
+	dst   bool
+}
+
+// icsZoneRule is a STANDARD or DAYLIGHT observance of a VTIMEZONE,
+// recurring yearly on a weekday of a month like the second Sunday of March, if month isn't 0.
+// Other recurrences are read as observed once.
+type icsZoneRule struct {
+	icsObservance
+	start string // of the onset, read by the offset before it
+
+	month   time.Month
+	week    int // of the month, or from the last one if negative
+	weekday time.Weekday
+	until   time.Time
+}
+
+func (r *icsZoneRule) set(p icsProperty) error {
+	var err error
+	switch p.name {
+	case "DTSTART":
+		r.start = p.value
+	case "TZOFFSETFROM":
+		r.from, err = parseICSOffset(p.value)
+	case "TZOFFSETTO":
+		r.to, err = parseICSOffset(p.value)
+	case "TZNAME":
+		r.abbr = p.value
+	case "RRULE":
+		r.parseRule(p.value)
+	}
+	return err
+}
+
+// parseStart parses the onset of r, once its offsets are read.
+func (r *icsZoneRule) parseStart() error {
+	t, err := time.ParseInLocation("20060102T150405", r.start, time.FixedZone("", r.from))
+	if err != nil {
+		return fmt.Errorf("invalid DTSTART %q", r.start)
+	}
+	r.onset = t
+	return nil
+}
+
+// parseRule reads rrule if it recurs yearly on a weekday of a month, like FREQ=YEARLY;BYMONTH=3;BYDAY=2SU.
+func (r *icsZoneRule) parseRule(rrule string) {
+	var month, week int
+	var weekday time.Weekday
+	var until time.Time
+	yearly := false
+	for _, part := range strings.Split(strings.ToUpper(rrule), ";") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			return
+		}
+		var err error
+		switch kv[0] {
+		case "FREQ":
+			yearly = kv[1] == "YEARLY"
+		case "INTERVAL":
+			if kv[1] != "1" {
+				return
+			}
+		case "BYMONTH":
+			month, err = strconv.Atoi(kv[1])
+		case "BYDAY":
+			day, ok := rruleWeekdays[strings.TrimLeft(kv[1], "+-0123456789")]
+			if !ok {
+				return
+			}
+			weekday = day
+			week, err = strconv.Atoi(strings.TrimSuffix(kv[1], kv[1][len(kv[1])-2:]))
+		case "UNTIL":
+			until, err = parseUntil(kv[1], time.FixedZone("", r.from))
+		default:
+			return
+		}
+		if err != nil {
+			return
+		}
+	}
+	if !yearly || month < 1 || month > 12 || week == 0 || week < -5 || week > 5 {
+		return
+	}
+	r.month, r.week, r.weekday, r.until = time.Month(month), week, weekday, until
+}
+
+var rruleWeekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// parseUntil parses a date or date-time, where a date means until the end of it.
+func parseUntil(s string, loc *time.Location) (time.Time, error) {
+	if t, err := time.ParseInLocation("20060102", s, loc); err == nil {
+		return t.AddDate(0, 0, 1).Add(-time.Nanosecond), nil
+	}
+	if strings.HasSuffix(s, "Z") {
+		return time.Parse("20060102T150405Z", s)
+	}
+	return time.ParseInLocation("20060102T150405", s, loc)
+}
+
+// onsets returns the onsets of r in the year and the one before it.
+func (r *icsZoneRule) onsets(year int) []time.Time {
+	if r.month == 0 {
+		return []time.Time{r.onset}
+	}
+	var onsets []time.Time
+	for y := year - 1; y <= year; y++ {
+		onset := time.Date(y, r.month, weekdayOfMonth(y, r.month, r.week, r.weekday),
+			r.onset.Hour(), r.onset.Minute(), r.onset.Second(), 0, r.onset.Location())
+		if timeLT(onset, r.onset) || !r.until.IsZero() && timeGT(onset, r.until) {
+			continue
+		}
+		onsets = append(onsets, onset)
+	}
+	return onsets
+}
+
+// weekdayOfMonth returns the day of the nth weekday of a month, or of the -nth from the last if n is negative.
+func weekdayOfMonth(year int, month time.Month, n int, weekday time.Weekday) int {
+	if n > 0 {
+		first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC).Weekday()
+		return 1 + (int(weekday-first)+7)%7 + 7*(n-1)
+	}
+	last := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC)
+	return last.Day() - (int(last.Weekday()-weekday)+7)%7 + 7*(n+1)
+}
+
+// parseICSOffset parses an offset like +0900 or -0430 as seconds.
+func parseICSOffset(s string) (int, error) {
+	invalid := fmt.Errorf("invalid offset %q", s)
+	if len(s) != len("+0900") && len(s) != len("+090000") || s[0] != '+' && s[0] != '-' {
+		return 0, invalid
+	}
+	seconds := 0
+	for i, unit := range []int{3600, 60, 1} {
+		if 1+2*i >= len(s) {
+			break
+		}
+		n, err := strconv.Atoi(s[1+2*i : 3+2*i])
+		if err != nil {
+			return 0, invalid
+		}
+		seconds += n * unit
+	}
+	if s[0] == '-' {
+		seconds = -seconds
+	}
+	return seconds, nil
+}
+
+// icsDuration is a DURATION like P1DT2H30M, of which weeks and days are nominal
+// and the rest is exact.
+type icsDuration struct {
+	days int
+	d    time.Duration
+}
+
+func (d icsDuration) addTo(t time.Time) time.Time {
+	return t.AddDate(0, 0, d.days).Add(d.d)
+}
+
+func parseICSDuration(s string) (icsDuration, error) {
+	invalid := fmt.Errorf("invalid duration %q", s)
+	sign := 1
+	v := s
+	switch {
+	case strings.HasPrefix(v, "-"):
+		sign = -1
+		v = v[1:]
+	case strings.HasPrefix(v, "+"):
+		v = v[1:]
+	}
+	if !strings.HasPrefix(v, "P") || len(v) < 3 {
+		return icsDuration{}, invalid
+	}
+
+	var d icsDuration
+	inTime := false
+	n := ""
+	for _, r := range v[1:] {
+		switch {
+		case r >= '0' && r <= '9':
+			n += string(r)
+			continue
+		case r == 'T' && !inTime && n == "":
+			inTime = true
+			continue
+		}
+		x, err := strconv.Atoi(n)
+		if err != nil {
+			return icsDuration{}, invalid
+		}
+		n = ""
+		switch {
+		case r == 'W' && !inTime:
+			d.days += 7 * x
+		case r == 'D' && !inTime:
+			d.days += x
+		case r == 'H' && inTime:
+			d.d += time.Duration(x) * time.Hour
+		case r == 'M' && inTime:
+			d.d += time.Duration(x) * time.Minute
+		case r == 'S' && inTime:
+			d.d += time.Duration(x) * time.Second
+		default:
+			return icsDuration{}, invalid
+		}
+	}
+	if n != "" {
+		return icsDuration{}, invalid
+	}
+	d.days *= sign
+	d.d *= time.Duration(sign)
+	return d, nil
+}
@@ -0,0 +1,131 @@
+package timechart
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadICS(t *testing.T) {
+	seoul, err := time.LoadLocation("Asia/Seoul")
+	assert.NoError(t, err)
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+	pst := time.FixedZone("Pacific Standard Time", -8*60*60)
+	pdt := time.FixedZone("Pacific Standard Time", -7*60*60)
+
+	cases := []struct {
+		file     string
+		expected []Schedule
+	}{
+		{
+			file: "basic.ics",
+			expected: []Schedule{
+				{time.Date(2022, 2, 1, 9, 0, 0, 0, time.UTC), time.Date(2022, 2, 1, 9, 30, 0, 0, time.UTC)},
+				{time.Date(2022, 2, 1, 13, 0, 0, 0, time.UTC), time.Date(2022, 2, 1, 14, 30, 0, 0, time.UTC)},
+			},
+		},
+		{
+			file: "timezones.ics",
+			expected: []Schedule{
+				{time.Date(2022, 2, 1, 9, 0, 0, 0, seoul), time.Date(2022, 2, 1, 10, 0, 0, 0, seoul)},
+				{time.Date(2022, 2, 1, 17, 0, 0, 0, newYork), time.Date(2022, 2, 1, 18, 0, 0, 0, newYork)},
+				{time.Date(2022, 2, 1, 22, 0, 0, 0, seoul), time.Date(2022, 2, 2, 2, 0, 0, 0, seoul)},
+			},
+		},
+		{
+			file: "outlook.ics",
+			expected: []Schedule{
+				{time.Date(2022, 2, 1, 9, 0, 0, 0, pst), time.Date(2022, 2, 1, 10, 0, 0, 0, pst)},
+				{time.Date(2022, 7, 1, 9, 0, 0, 0, pdt), time.Date(2022, 7, 1, 10, 0, 0, 0, pdt)},
+				{time.Date(2022, 3, 13, 1, 0, 0, 0, pst), time.Date(2022, 3, 13, 4, 0, 0, 0, pdt)},
+			},
+		},
+		{
+			file: "allday.ics",
+			expected: []Schedule{
+				{time.Date(2022, 2, 1, 0, 0, 0, 0, time.Local), time.Date(2022, 2, 2, 0, 0, 0, 0, time.Local)},
+				{time.Date(2022, 2, 3, 0, 0, 0, 0, time.Local), time.Date(2022, 2, 5, 0, 0, 0, 0, time.Local)},
+				{time.Date(2022, 2, 7, 0, 0, 0, 0, time.Local), time.Date(2022, 2, 14, 0, 0, 0, 0, time.Local)},
+			},
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.file, func(t *testing.T) {
+			f, err := os.Open(filepath.Join("testdata", tc.file))
+			assert.NoError(t, err)
+			defer f.Close()
+
+			got, err := ReadICS(f)
+			assert.NoError(t, err)
+			assert.Equal(t, len(tc.expected), len(got))
+			for i := range tc.expected {
+				assert.True(t, tc.expected[i].Start.Equal(got[i].Start), "start of %d: %s", i, got[i].Start)
+				assert.True(t, tc.expected[i].End.Equal(got[i].End), "end of %d: %s", i, got[i].End)
+				assert.Equal(t, tc.expected[i].Start.Location().String(), got[i].Start.Location().String())
+			}
+		})
+	}
+}
+
+func TestReadICS_invalid(t *testing.T) {
+	f, err := os.Open(filepath.Join("testdata", "invalid.ics"))
+	assert.NoError(t, err)
+	defer f.Close()
+
+	_, err = ReadICS(f)
+	assert.ErrorIs(t, err, ErrInvalidICS)
+	assert.EqualError(t, err, `timechart: invalid iCalendar: line 6: DTEND: invalid date-time "2022-02-01 10:00"`)
+
+	cases := []struct {
+		name string
+		ics  string
+	}{
+		{
+			name: "no start",
+			ics:  "BEGIN:VEVENT\nDTEND:20220201T100000Z\nEND:VEVENT\n",
+		},
+		{
+			name: "unknown TZID",
+			ics:  "BEGIN:VEVENT\nDTSTART;TZID=Mars/Olympus_Mons:20220201T100000\nEND:VEVENT\n",
+		},
+		{
+			name: "invalid offset",
+			ics:  "BEGIN:VTIMEZONE\nTZID:Office\nBEGIN:STANDARD\nDTSTART:19700101T000000\nTZOFFSETFROM:9\nEND:STANDARD\nEND:VTIMEZONE\n",
+		},
+		{
+			name: "invalid duration",
+			ics:  "BEGIN:VEVENT\nDTSTART:20220201T100000Z\nDURATION:1H\nEND:VEVENT\n",
+		},
+		{
+			name: "no value",
+			ics:  "BEGIN:VEVENT\nDTSTART\nEND:VEVENT\n",
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ReadICS(strings.NewReader(tc.ics))
+			assert.ErrorIs(t, err, ErrInvalidICS)
+		})
+	}
+}
+
+func TestReadICS_format(t *testing.T) {
+	seoul, err := time.LoadLocation("Asia/Seoul")
+	assert.NoError(t, err)
+	f, err := os.Open(filepath.Join("testdata", "timezones.ics"))
+	assert.NoError(t, err)
+	defer f.Close()
+
+	ss, err := ReadICS(f)
+	assert.NoError(t, err)
+
+	got := NewHalfHourIncrementFormatter(NewUnicodeChar, WithLocation(seoul)).Format(OverlapSchedules(ss))
+	assert.Equal(t, "├──┼──┼──┼──┼──┼──┼──┾━━┽──┾━━┽──┼──┤├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┾━━┿━━┥", got)
+}
@@ -0,0 +1,199 @@
+package timechart
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ErrNoDate is returned when a schedule of times of day made by NewTime is written where dates are needed.
+var ErrNoDate = errors.New("timechart: schedule has no date")
+
+// WriteICS writes ss as the events of an iCalendar (RFC 5545) stream stamped with the current time.
+func WriteICS(w io.Writer, ss []Schedule) error {
+	return WriteICSWithTime(w, ss, time.Now())
+}
+
+// WriteICSWithTime is like WriteICS but stamps the events with stamp, for reproducible output.
+// Times are written in UTC unless they are in a named location other than time.Local,
+// which is then described by a VTIMEZONE. Each event gets a UID derived from its times,
+// so exporting the same schedule again updates the event instead of adding one.
+// It returns a *ScheduleError if any of ss is invalid or a time of day, which has no date to be written.
+func WriteICSWithTime(w io.Writer, ss []Schedule, stamp time.Time) error {
+	if err := ValidateSchedules(ss); err != nil {
+		return err
+	}
+	for i, s := range ss {
+		if isTimeOfDay(s.Start) || isTimeOfDay(s.End) {
+			return &ScheduleError{Index: i, Schedule: s, Err: ErrNoDate}
+		}
+	}
+
+	var b strings.Builder
+	line := func(format string, args ...interface{}) {
+		fmt.Fprintf(&b, format+"\r\n", args...)
+	}
+	line("BEGIN:VCALENDAR")
+	line("VERSION:2.0")
+	line("PRODID:-//JungWinter//go-timechart//EN")
+	line("CALSCALE:GREGORIAN")
+	for _, z := range icsZones(ss) {
+		z.write(line)
+	}
+	uids := map[string]int{}
+	for _, s := range ss {
+		base := icsUID(s)
+		uid := base
+		if n := uids[base]; n > 0 {
+			uid = fmt.Sprintf("%s-%d", base, n) // of a duplicate
+		}
+		uids[base]++
+
+		line("BEGIN:VEVENT")
+		line("UID:%s@go-timechart", uid)
+		line("DTSTAMP:%s", stamp.UTC().Format("20060102T150405Z"))
+		line("DTSTART%s", icsTime(s.Start))
+		line("DTEND%s", icsTime(s.End))
+		line("END:VEVENT")
+	}
+	line("END:VCALENDAR")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// icsUID returns an identifier of the times of s.
+func icsUID(s Schedule) string {
+	sum := sha1.Sum([]byte(s.Start.UTC().Format(time.RFC3339Nano) + "/" + s.End.UTC().Format(time.RFC3339Nano)))
+	return hex.EncodeToString(sum[:10])
+}
+
+// icsTime returns the parameters and value of a DTSTART or DTEND of t, e.g. ;TZID=Asia/Seoul:20220201T090000.
+func icsTime(t time.Time) string {
+	if name, ok := icsZoneName(t.Location()); ok {
+		return fmt.Sprintf(";TZID=%s:%s", name, t.Format("20060102T150405"))
+	}
+	return ":" + t.UTC().Format("20060102T150405Z")
+}
+
+// icsZoneName returns the TZID of loc, or false if times in loc are written in UTC.
+func icsZoneName(loc *time.Location) (string, bool) {
+	switch name := loc.String(); name {
+	case "UTC", "Local", "":
+		return "", false
+	default:
+		return name, true
+	}
+}
+
+// icsZone is a VTIMEZONE describing a location over the times of the schedules in it.
+type icsZone struct {
+	name        string
+	observances []icsObservance
+}
+
+// icsZones returns the zones of the locations of ss sorted by their names.
+func icsZones(ss []Schedule) []icsZone {
+	type span struct {
+		loc      *time.Location
+		from, to time.Time
+	}
+	spans := map[string]*span{}
+	for _, s := range ss {
+		for _, t := range []time.Time{s.Start, s.End} {
+			name, ok := icsZoneName(t.Location())
+			if !ok {
+				continue
+			}
+			sp, ok := spans[name]
+			if !ok {
+				spans[name] = &span{loc: t.Location(), from: t, to: t}
+				continue
+			}
+			if timeLT(t, sp.from) {
+				sp.from = t
+			}
+			if timeGT(t, sp.to) {
+				sp.to = t
+			}
+		}
+	}
+
+	zones := make([]icsZone, 0, len(spans))
+	for name, sp := range spans {
+		zones = append(zones, icsZone{name: name, observances: observances(sp.loc, sp.from, sp.to)})
+	}
+	sort.Slice(zones, func(i, j int) bool {
+		return zones[i].name < zones[j].name
+	})
+	return zones
+}
+
+// observances returns the offset of loc from the midnight of a day before from, and every change of it until a day after to.
+func observances(loc *time.Location, from, to time.Time) []icsObservance {
+	observe := func(t time.Time, from int) icsObservance {
+		abbr, offset := t.In(loc).Zone()
+		return icsObservance{onset: t, from: from, to: offset, abbr: abbr, dst: t.In(loc).IsDST()}
+	}
+	y, m, d := from.In(loc).Date()
+	start := time.Date(y, m, d-1, 0, 0, 0, 0, loc)
+	_, offset := start.In(loc).Zone()
+	obs := []icsObservance{observe(start, offset)}
+	for t := start; timeLT(t, to.Add(24*time.Hour)); {
+		next := t.Add(24 * time.Hour)
+		if _, o := next.In(loc).Zone(); o != offset {
+			// search the first second of the new offset
+			lo, hi := t, next
+			for hi.Sub(lo) > time.Second {
+				mid := lo.Add(hi.Sub(lo) / 2).Truncate(time.Second)
+				if _, mo := mid.In(loc).Zone(); mo == offset {
+					lo = mid
+				} else {
+					hi = mid
+				}
+			}
+			obs = append(obs, observe(hi, offset))
+			_, offset = hi.In(loc).Zone()
+		}
+		t = next
+	}
+	return obs
+}
+
+func (z icsZone) write(line func(string, ...interface{})) {
+	line("BEGIN:VTIMEZONE")
+	line("TZID:%s", z.name)
+	for _, o := range z.observances {
+		kind := "STANDARD"
+		if o.dst {
+			kind = "DAYLIGHT"
+		}
+		line("BEGIN:%s", kind)
+		// the onset is written in the local time before it
+		line("DTSTART:%s", o.onset.In(time.FixedZone("", o.from)).Format("20060102T150405"))
+		line("TZOFFSETFROM:%s", icsOffset(o.from))
+		line("TZOFFSETTO:%s", icsOffset(o.to))
+		line("TZNAME:%s", o.abbr)
+		line("END:%s", kind)
+	}
+	line("END:VTIMEZONE")
+}
+
+// icsOffset returns an offset like +0900 or -0430.
+func icsOffset(seconds int) string {
+	sign := "+"
+	if seconds < 0 {
+		sign = "-"
+		seconds = -seconds
+	}
+	offset := fmt.Sprintf("%s%02d%02d", sign, seconds/3600, seconds/60%60)
+	if s := seconds % 60; s != 0 {
+		offset += fmt.Sprintf("%02d", s)
+	}
+	return offset
+}
@@ -0,0 +1,118 @@
+package timechart
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteICSWithTime(t *testing.T) {
+	seoul, err := time.LoadLocation("Asia/Seoul")
+	assert.NoError(t, err)
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+
+	ss := OverlapSchedules([]Schedule{
+		{time.Date(2022, 3, 12, 9, 0, 0, 0, newYork), time.Date(2022, 3, 12, 10, 0, 0, 0, newYork)},
+		{time.Date(2022, 3, 13, 9, 0, 0, 0, newYork), time.Date(2022, 3, 13, 10, 0, 0, 0, newYork)},
+		{time.Date(2022, 3, 13, 10, 0, 0, 0, newYork), time.Date(2022, 3, 13, 11, 0, 0, 0, newYork)},
+		{time.Date(2022, 3, 14, 9, 0, 0, 0, seoul), time.Date(2022, 3, 14, 12, 0, 0, 0, seoul)},
+		{time.Date(2022, 3, 14, 13, 0, 0, 0, time.UTC), time.Date(2022, 3, 14, 14, 0, 0, 0, time.UTC)},
+	})
+	stamp := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	var b bytes.Buffer
+	assert.NoError(t, WriteICSWithTime(&b, ss, stamp))
+	expected, err := os.ReadFile(filepath.Join("testdata", "export.ics"))
+	assert.NoError(t, err)
+	assert.Equal(t, string(expected), b.String())
+
+	got, err := ReadICS(&b)
+	assert.NoError(t, err)
+	assert.Equal(t, len(ss), len(got))
+	for i := range ss {
+		assert.True(t, ss[i].Start.Equal(got[i].Start), "start of %d", i)
+		assert.True(t, ss[i].End.Equal(got[i].End), "end of %d", i)
+		assert.Equal(t, ss[i].Start.Location().String(), got[i].Start.Location().String())
+	}
+}
+
+func TestWriteICSWithTime_fixedZone(t *testing.T) {
+	kst := time.FixedZone("KST", 9*60*60)
+	cet := time.FixedZone("CET", 60*60) // named as a location observing CEST in July
+	cases := []struct {
+		name      string
+		schedules []Schedule
+		dtstart   string
+	}{
+		{
+			name: "not a location",
+			schedules: []Schedule{
+				{time.Date(2022, 3, 14, 9, 0, 0, 0, kst), time.Date(2022, 3, 14, 12, 0, 0, 0, kst)},
+				{time.Date(2022, 3, 14, 22, 0, 0, 0, kst), time.Date(2022, 3, 15, 2, 0, 0, 0, kst)},
+			},
+			dtstart: "DTSTART;TZID=KST:20220314T090000\r\n",
+		},
+		{
+			name: "named as a location",
+			schedules: []Schedule{
+				{time.Date(2022, 7, 14, 8, 0, 0, 0, cet), time.Date(2022, 7, 14, 9, 0, 0, 0, cet)},
+			},
+			dtstart: "DTSTART;TZID=CET:20220714T080000\r\n",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var b bytes.Buffer
+			assert.NoError(t, WriteICSWithTime(&b, tc.schedules, time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)))
+			assert.Contains(t, b.String(), tc.dtstart)
+
+			got, err := ReadICS(&b)
+			assert.NoError(t, err)
+			assert.Equal(t, len(tc.schedules), len(got))
+			for i, s := range tc.schedules {
+				assert.True(t, s.Start.Equal(got[i].Start), "start of %d: %v", i, got[i].Start)
+				assert.True(t, s.End.Equal(got[i].End), "end of %d: %v", i, got[i].End)
+				assert.Equal(t, s.Start.Location().String(), got[i].Start.Location().String())
+			}
+		})
+	}
+}
+
+func TestWriteICSWithTime_invalid(t *testing.T) {
+	cases := []struct {
+		name      string
+		schedules []Schedule
+		err       error
+	}{
+		{
+			name: "time of day",
+			schedules: []Schedule{
+				{newTime(9, 0), newTime(10, 0)},
+			},
+			err: ErrNoDate,
+		},
+		{
+			name: "end before start",
+			schedules: []Schedule{
+				{time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC), time.Date(2022, 3, 1, 9, 0, 0, 0, time.UTC)},
+			},
+			err: ErrEndBeforeStart,
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var b bytes.Buffer
+			err := WriteICSWithTime(&b, tc.schedules, time.Now())
+			assert.ErrorIs(t, err, tc.err)
+			assert.Empty(t, b.String())
+		})
+	}
+}
@@ -0,0 +1,296 @@
+package timechart
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cell is a single character position of a chart.
+// A slot covers [from, to) while hours and edges sit on a point in time.
+type cell struct {
+	t     charType
+	start bool // the edge opens a segment
+
+	from time.Time
+	to   time.Time
+}
+
+// after returns whether c is drawn for a range beginning at t.
+func (c cell) after(t time.Time) bool {
+	switch {
+	case c.t == slot:
+		return timeGT(c.to, t)
+	case c.t == edge && !c.start:
+		return timeGT(c.from, t)
+	default:
+		return timeGTE(c.from, t)
+	}
+}
+
+// before returns whether c is drawn for a range ending at t.
+func (c cell) before(t time.Time) bool {
+	switch {
+	case c.t == slot:
+		return timeLTE(c.to, t)
+	case c.t == edge && c.start:
+		return timeLT(c.from, t)
+	default:
+		return timeLTE(c.from, t)
+	}
+}
+
+type layout []cell
+
+// newLayout lays out slots of the given resolution over consecutive segments.
+// bounds are the edges of the segments, e.g. [00:00, 12:00, 24:00] for am + pm.
+// Slots last for resolution in elapsed time while hours are ticked by the wall clock,
+// so a day of a daylight saving time transition lasts for 23 or 25 hours.
+// Slots are aligned to origin, so a segment beginning off them starts with a shorter slot.
+func newLayout(origin time.Time, bounds []time.Time, resolution time.Duration) layout {
+	var l layout
+	for i := 0; i+1 < len(bounds); i++ {
+		from, to := bounds[i], bounds[i+1]
+		l = append(l, cell{t: edge, start: true, from: from, to: from})
+		for b := from; timeLT(b, to); {
+			if !b.Equal(from) && onHour(b) {
+				l = append(l, cell{t: hour, from: b, to: b})
+			}
+			next := origin.Add((b.Sub(origin)/resolution + 1) * resolution)
+			if timeGT(next, to) {
+				next = to
+			}
+			l = append(l, cell{t: slot, from: b, to: next})
+			b = next
+		}
+		l = append(l, cell{t: edge, from: to, to: to})
+	}
+	return l
+}
+
+// onHour returns whether the wall clock of t is on the hour.
+func onHour(t time.Time) bool {
+	return t.Minute() == 0 && t.Second() == 0 && t.Nanosecond() == 0
+}
+
+// chars returns the empty chart of l drawn with fn.
+func (l layout) chars(fn func() Char) Chars {
+	cc := make(Chars, len(l))
+	for i, c := range l {
+		ch := fn()
+		switch c.t {
+		case hour:
+			ch = ch.Hour()
+		case edge:
+			if c.start {
+				ch = ch.Start()
+			} else {
+				ch = ch.End()
+			}
+			ch = ch.Edge()
+		case slot:
+			ch = ch.Slot()
+		}
+		cc[i] = ch
+	}
+	return cc
+}
+
+// startIndex returns the index of the first cell drawn for a range beginning at t.
+func (l layout) startIndex(t time.Time) int {
+	return sort.Search(len(l), func(i int) bool {
+		return l[i].after(t)
+	})
+}
+
+// endIndex returns the index next to the last cell drawn for a range ending at t.
+func (l layout) endIndex(t time.Time) int {
+	return sort.Search(len(l), func(i int) bool {
+		return !l[i].before(t)
+	})
+}
+
+// fill draws cs over the empty chart of l.
+// A cell covered by several categories is drawn as the one preceding the others.
+// If partial, slots covered partly are drawn too, by their coverage if fn makes a PartialChar.
+func (l layout) fill(fn func() Char, cs []CategorizedSchedule, partial bool) Chars {
+	base := l.chars(fn)
+	if len(cs) == 0 {
+		return base
+	}
+
+	ss := make([]Schedule, len(cs))
+	for i, c := range cs {
+		ss[i] = c.Schedule
+	}
+	coverages := make([]*coverage, len(base))
+	for _, schedule := range OverlapSchedules(ss) {
+		s, e := l.indexRange(schedule, partial)
+		if e < s {
+			continue // ends before it starts
+		}
+		for i, c := range base[s:e] {
+			if i == 0 {
+				c = c.Start()
+			}
+			if i == len(base[s:e])-1 {
+				c = c.End()
+			}
+			c = c.Fill()
+			base[s+i] = c
+		}
+		if partial {
+			for i := s; i < e; i++ {
+				coverages[i] = coverages[i].add(l[i], schedule)
+			}
+		}
+	}
+
+	categories := make([]Category, len(base))
+	covered := make([]bool, len(base))
+	for _, c := range cs {
+		s, e := l.indexRange(c.Schedule, partial)
+		for i := s; i < e; i++ {
+			if !covered[i] || c.Category.precedes(categories[i]) {
+				categories[i] = c.Category
+				covered[i] = true
+			}
+		}
+	}
+	for i, category := range categories {
+		if cc, ok := base[i].(CategoryChar); ok && covered[i] {
+			base[i] = cc.Category(category)
+		}
+	}
+	for i, cov := range coverages {
+		if pc, ok := base[i].(PartialChar); ok && cov.partial() {
+			base[i] = pc.Partial(cov.from, cov.to)
+		}
+	}
+	return base
+}
+
+// crop marks the first edge of l if start, and the last if end, where schedules of cs go on beyond l,
+// so the schedules are drawn cut off there by charsets implementing CroppedChar.
+func (l layout) crop(base Chars, cs []CategorizedSchedule, start, end bool) Chars {
+	if len(l) == 0 {
+		return base
+	}
+	first, last := l[0].from, l[len(l)-1].to
+	for _, c := range cs {
+		if start && timeLT(c.Start, first) && timeGT(c.End, first) {
+			if cc, ok := base[0].(CroppedChar); ok {
+				base[0] = cc.Cropped()
+			}
+		}
+		if end && timeLT(c.Start, last) && timeGT(c.End, last) {
+			if cc, ok := base[len(base)-1].(CroppedChar); ok {
+				base[len(base)-1] = cc.Cropped()
+			}
+		}
+	}
+	return base
+}
+
+// indexRange returns [s, e) of the cells drawn for schedule.
+// A slot which schedule ends in the middle of is drawn only if partial.
+func (l layout) indexRange(schedule Schedule, partial bool) (int, int) {
+	s, e := l.startIndex(schedule.Start), l.endIndex(schedule.End)
+	if partial && e < len(l) && l[e].t == slot && timeLT(l[e].from, schedule.End) && timeLT(schedule.Start, schedule.End) {
+		e++
+	}
+	return s, e
+}
+
+// coverage is the part of a slot covered by schedules, as fractions of the slot.
+type coverage struct {
+	from    float64 // where the first schedule starts
+	to      float64 // where the last schedule ends
+	covered float64 // how much is covered in total
+}
+
+// add returns cov grown by where schedule lies on c, if c is a slot.
+// schedule must not overlap the ones added before.
+func (cov *coverage) add(c cell, schedule Schedule) *coverage {
+	if c.t != slot {
+		return cov
+	}
+	length := float64(c.to.Sub(c.from))
+	from, to := 0.0, 1.0
+	if timeGT(schedule.Start, c.from) {
+		from = float64(schedule.Start.Sub(c.from)) / length
+	}
+	if timeLT(schedule.End, c.to) {
+		to = float64(schedule.End.Sub(c.from)) / length
+	}
+	if cov == nil {
+		return &coverage{from: from, to: to, covered: to - from}
+	}
+	return &coverage{
+		from:    math.Min(cov.from, from),
+		to:      math.Max(cov.to, to),
+		covered: cov.covered + to - from,
+	}
+}
+
+// partial returns whether cov covers a part of its slot only.
+func (cov *coverage) partial() bool {
+	return cov != nil && cov.covered < 1
+}
+
+// nowIndex returns the index of the cell marking t:
+// the hour or edge beginning at t if any, or else the slot containing t.
+func (l layout) nowIndex(t time.Time) int {
+	i := l.startIndex(t)
+	if i == len(l) {
+		i -= 1 // the end of l
+	}
+	return i
+}
+
+// contains returns whether t is drawn by l.
+func (l layout) contains(t time.Time) bool {
+	return len(l) > 0 && timeGTE(t, l[0].from) && timeLT(t, l[len(l)-1].to)
+}
+
+// header returns a line labeling the hours of l which are multiples of every,
+// each label starting at the column of its hour or edge.
+func (l layout) header(every time.Duration) string {
+	line := []rune(strings.Repeat(" ", len(l)))
+	for _, label := range l.labels(every) {
+		copy(line[label.column:], []rune(label.text))
+	}
+	return strings.TrimRight(string(line), " ")
+}
+
+// label is a label of an hour in the header of a layout.
+type label struct {
+	column int
+	text   string
+}
+
+// labels returns the labels of the hours of l which are multiples of every,
+// leaving out those which would overlap their previous one or overflow l.
+func (l layout) labels(every time.Duration) []label {
+	var labels []label
+	n := int(every / time.Hour)
+	next := 0 // the first column free to label
+	for i, c := range l {
+		if c.t == slot || i < next || c.from.Minute() != 0 || c.from.Hour()%n != 0 {
+			continue
+		}
+		h := c.from.Hour()
+		if c.t == edge && !c.start && h == 0 {
+			h = 24 // closing the day
+		}
+		text := strconv.Itoa(h)
+		if i+len(text) > len(l) {
+			continue
+		}
+		labels = append(labels, label{column: i, text: text})
+		next = i + len(text) + 1
+	}
+	return labels
+}
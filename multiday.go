@@ -0,0 +1,182 @@
+package timechart
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// maxDays is the most days a MultiDayFormatter draws, a leap year.
+const maxDays = 366
+
+// ErrTooManyDays is returned when a MultiDayFormatter would draw more than a year of days.
+var ErrTooManyDays = errors.New("timechart: too many days")
+
+// MultiDayFormatter draws schedules on the calendar days of [from, to),
+// each day laid out as its IncrementFormatter does.
+type MultiDayFormatter struct {
+	day   IncrementFormatter
+	from  time.Time
+	to    time.Time
+	strip bool
+}
+
+var _ CheckedFormatter = MultiDayFormatter{}
+
+// NewMultiDayFormatter returns a MultiDayFormatter drawing every day overlapping [from, to)
+// in its own row. Days begin at midnight in the location given by WithLocation to day,
+// or else in the location of from. At most 366 days from from are drawn, and the rest are left out.
+// Times of day made by NewTime are drawn on every day.
+func NewMultiDayFormatter(day IncrementFormatter, from, to time.Time) MultiDayFormatter {
+	return MultiDayFormatter{
+		day:  day,
+		from: from,
+		to:   to,
+	}
+}
+
+// Strip returns a copy of f drawing all days in a single line,
+// joined by edges at midnight.
+func (f MultiDayFormatter) Strip() MultiDayFormatter {
+	f.strip = true
+	return f
+}
+
+func (f MultiDayFormatter) Format(ss []Schedule) string {
+	return f.FormatCategories(categorize(ss))
+}
+
+func (f MultiDayFormatter) FormatNow(ss []Schedule) string {
+	return f.FormatWithTime(ss, time.Now())
+}
+
+func (f MultiDayFormatter) FormatWithTime(ss []Schedule, t time.Time) string {
+	return f.FormatCategoriesWithTime(categorize(ss), t)
+}
+
+// FormatCategories is like Format but fills each schedule by its category.
+func (f MultiDayFormatter) FormatCategories(cs []CategorizedSchedule) string {
+	return f.join(f.fill(cs))
+}
+
+// FormatCategoriesWithTime is like FormatWithTime but fills each schedule by its category.
+func (f MultiDayFormatter) FormatCategoriesWithTime(cs []CategorizedSchedule, t time.Time) string {
+	rows := f.fill(cs)
+	for i, l := range f.layouts() {
+		if l.contains(t) {
+			j := l.nowIndex(t)
+			rows[i][j] = rows[i][j].Now()
+		}
+	}
+	return f.join(rows)
+}
+
+// FormatChecked is like Format but returns a *ScheduleError if any of ss is invalid,
+// or ErrTooManyDays if f leaves days out.
+func (f MultiDayFormatter) FormatChecked(ss []Schedule) (string, error) {
+	if err := f.validate(ss); err != nil {
+		return "", err
+	}
+	return f.Format(ss), nil
+}
+
+// FormatWithTimeChecked is like FormatWithTime but returns a *ScheduleError if any of ss is invalid,
+// or ErrTooManyDays if f leaves days out.
+func (f MultiDayFormatter) FormatWithTimeChecked(ss []Schedule, t time.Time) (string, error) {
+	if err := f.validate(ss); err != nil {
+		return "", err
+	}
+	return f.FormatWithTime(ss, t), nil
+}
+
+func (f MultiDayFormatter) validate(ss []Schedule) error {
+	if err := ValidateSchedules(ss); err != nil {
+		return err
+	}
+	if days := f.days(); len(days) == maxDays && timeLT(days[len(days)-1].AddDate(0, 0, 1), f.to) {
+		return ErrTooManyDays
+	}
+	return nil
+}
+
+func (f MultiDayFormatter) fill(cs []CategorizedSchedule) []Chars {
+	days := f.days()
+	rows := make([]Chars, len(days))
+	for i, day := range days {
+		l := f.day.dayLayout(day)
+		daily := everyDay(cs, day)
+		rows[i] = f.day.crop(l, l.fill(f.day.fn, daily, f.day.partial), daily)
+	}
+	return rows
+}
+
+// everyDay returns cs with times of day made by NewTime moved onto day, as they're drawn on every day,
+// including those of the days before which go on into day.
+func everyDay(cs []CategorizedSchedule, day time.Time) []CategorizedSchedule {
+	var daily []CategorizedSchedule
+	for _, c := range cs {
+		if !isTimeOfDay(c.Start) {
+			daily = append(daily, c)
+			continue
+		}
+		for n := daysBetween(c.Start, c.End); n >= 0; n-- {
+			s := onDay(c.Schedule, day.AddDate(0, 0, -n))
+			daily = append(daily, CategorizedSchedule{Schedule: s, Category: c.Category})
+		}
+	}
+	return daily
+}
+
+func (f MultiDayFormatter) join(rows []Chars) string {
+	sep := "\n"
+	if f.strip {
+		sep = ""
+	}
+	ss := make([]string, len(rows))
+	for i, row := range rows {
+		ss[i] = row.String()
+	}
+	chart := strings.Join(ss, sep)
+	if h := f.header(); h != "" {
+		return h + "\n" + chart
+	}
+	return chart
+}
+
+// header returns the header of the day formatter above the rows, or across the strip.
+func (f MultiDayFormatter) header() string {
+	layouts := f.layouts()
+	if f.day.header == 0 || len(layouts) == 0 {
+		return ""
+	}
+	if !f.strip {
+		return layouts[0].header(f.day.header)
+	}
+	var strip layout
+	for _, l := range layouts {
+		strip = append(strip, l...)
+	}
+	return strip.header(f.day.header)
+}
+
+// layouts returns the cells of each day to draw.
+func (f MultiDayFormatter) layouts() []layout {
+	var layouts []layout
+	for _, day := range f.days() {
+		layouts = append(layouts, f.day.dayLayout(day))
+	}
+	return layouts
+}
+
+// days returns the midnights of the days overlapping [from, to), up to maxDays of them,
+// in the location of the day formatter or else of from.
+func (f MultiDayFormatter) days() []time.Time {
+	var days []time.Time
+	from := f.day.in(f.from)
+	y, m, d := from.Date()
+	for day := time.Date(y, m, d, 0, 0, 0, 0, from.Location()); timeLT(day, f.to) && len(days) < maxDays; {
+		days = append(days, day)
+		day = time.Date(day.Year(), day.Month(), day.Day()+1, 0, 0, 0, 0, day.Location())
+	}
+	return days
+}
@@ -0,0 +1,240 @@
+package timechart
+
+import (
+	"strings"
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultiDayFormatter_Format(t *testing.T) {
+	day := NewHalfHourIncrementFormatter(NewUnicodeChar).IncrementFormatter
+	withHeader := NewHalfHourIncrementFormatter(NewUnicodeChar, WithHeader(6*time.Hour)).IncrementFormatter
+	workingHours := NewHalfHourIncrementFormatter(NewUnicodeChar, WithWindow(8*time.Hour, 20*time.Hour)).IncrementFormatter
+	empty := "├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤"
+	cases := []struct {
+		name      string
+		f         MultiDayFormatter
+		schedules []Schedule
+		expected  string
+	}{
+		{
+			name:      "single day",
+			f:         NewMultiDayFormatter(day, date(2022, 2, 5, 0, 0), date(2022, 2, 6, 0, 0)),
+			schedules: nil,
+			expected:  "├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤",
+		},
+		{
+			name: "across midnight",
+			f:    NewMultiDayFormatter(day, date(2022, 2, 5, 0, 0), date(2022, 2, 7, 0, 0)),
+			schedules: []Schedule{
+				{date(2022, 2, 5, 22, 0), date(2022, 2, 6, 2, 0)},
+			},
+			expected: "├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┾━━┿━━┥\n" +
+				"┝━━┿━━┽──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤",
+		},
+		{
+			name: "days overlapping the window",
+			f:    NewMultiDayFormatter(day, date(2022, 2, 5, 18, 0), date(2022, 2, 6, 6, 0)),
+			schedules: []Schedule{
+				{date(2022, 2, 4, 10, 0), date(2022, 2, 4, 12, 0)},
+				{date(2022, 2, 6, 10, 0), date(2022, 2, 6, 12, 0)},
+			},
+			expected: "├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤\n" +
+				"├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┾━━┿━━┥├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤",
+		},
+		{
+			name: "times of day",
+			f:    NewMultiDayFormatter(day, date(2022, 2, 5, 0, 0), date(2022, 2, 7, 0, 0)),
+			schedules: []Schedule{
+				{newTime(9, 0), newTime(10, 0)},
+				{newTime(22, 0), newTime(2, 0).AddDate(0, 0, 1)},
+				{date(2022, 2, 6, 14, 0), date(2022, 2, 6, 15, 0)},
+			},
+			expected: "┝━━┿━━┽──┼──┼──┼──┼──┼──┼──┾━━┽──┼──┤├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┾━━┿━━┥\n" +
+				"┝━━┿━━┽──┼──┼──┼──┼──┼──┼──┾━━┽──┼──┤├──┼──┾━━┽──┼──┼──┼──┼──┼──┼──┾━━┿━━┥",
+		},
+		{
+			name: "cut off by working hours",
+			f:    NewMultiDayFormatter(workingHours, date(2022, 2, 5, 0, 0), date(2022, 2, 7, 0, 0)),
+			schedules: []Schedule{
+				{date(2022, 2, 5, 18, 0), date(2022, 2, 6, 10, 0)},
+			},
+			expected: "├──┼──┼──┼──┤├──┼──┼──┼──┼──┼──┾━━┿━━╡\n" +
+				"╞━━┿━━┽──┼──┤├──┼──┼──┼──┼──┼──┼──┼──┤",
+		},
+		{
+			name: "strip",
+			f:    NewMultiDayFormatter(day, date(2022, 2, 5, 0, 0), date(2022, 2, 7, 0, 0)).Strip(),
+			schedules: []Schedule{
+				{date(2022, 2, 5, 22, 0), date(2022, 2, 6, 2, 0)},
+			},
+			expected: "├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┾━━┿━━┥" +
+				"┝━━┿━━┽──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤",
+		},
+		{
+			name:     "header",
+			f:        NewMultiDayFormatter(withHeader, date(2022, 2, 5, 0, 0), date(2022, 2, 7, 0, 0)),
+			expected: "0                 6                 12                 18\n" + empty + "\n" + empty,
+		},
+		{
+			name: "header across strip",
+			f:    NewMultiDayFormatter(withHeader, date(2022, 2, 5, 0, 0), date(2022, 2, 7, 0, 0)).Strip(),
+			expected: "0                 6                 12                 18                24                 6                 12                 18\n" +
+				empty + empty,
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got := tc.f.Format(tc.schedules)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestMultiDayFormatter_FormatWithTime(t *testing.T) {
+	day := NewHalfHourIncrementFormatter(NewUnicodeChar).IncrementFormatter
+	f := NewMultiDayFormatter(day, date(2022, 2, 5, 0, 0), date(2022, 2, 7, 0, 0))
+	schedules := []Schedule{
+		{date(2022, 2, 5, 22, 0), date(2022, 2, 6, 2, 0)},
+	}
+	cases := []struct {
+		name     string
+		t        time.Time
+		expected string
+	}{
+		{
+			name: "first day",
+			t:    date(2022, 2, 5, 23, 0),
+			expected: "├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┾━━╋━━┥\n" +
+				"┝━━┿━━┽──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤",
+		},
+		{
+			name: "midnight",
+			t:    date(2022, 2, 6, 0, 0),
+			expected: "├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┾━━┿━━┥\n" +
+				"┣━━┿━━┽──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤",
+		},
+		{
+			name: "out of the window",
+			t:    date(2022, 2, 8, 10, 0),
+			expected: "├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┾━━┿━━┥\n" +
+				"┝━━┿━━┽──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤",
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got := f.FormatWithTime(schedules, tc.t)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestMultiDayFormatter_daylightSavingTime(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	assert.NoError(t, err)
+
+	cases := []struct {
+		name       string
+		resolution time.Duration
+		loc        *time.Location
+		day        time.Time
+		expected   string
+	}{
+		{
+			name:       "new york, 23 hours",
+			resolution: 30 * time.Minute,
+			loc:        newYork,
+			day:        time.Date(2022, 3, 13, 0, 0, 0, 0, newYork),
+			expected: "0  1  3  4  5  6  7  8  9  10 11 12  13 14 15 16 17 18 19 20 21 22 23\n" +
+				"├──┾━━┿━━┽──╂──┼──┼──┼──┼──┼──┼──┤├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤",
+		},
+		{
+			name:       "new york, 25 hours",
+			resolution: 30 * time.Minute,
+			loc:        newYork,
+			day:        time.Date(2022, 11, 6, 0, 0, 0, 0, newYork),
+			expected: "0  1  1  2  3  4  5  6  7  8  9  10 11 12  13 14 15 16 17 18 19 20 21 22 23\n" +
+				"├──┾━━┿━━┿━━┿━━┽──╂──┼──┼──┼──┼──┼──┼──┤├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤",
+		},
+		{
+			name:       "berlin, 23 hours",
+			resolution: time.Hour,
+			loc:        berlin,
+			day:        time.Date(2022, 3, 27, 0, 0, 0, 0, berlin),
+			expected: "0 1 3 4 5 6 7 8 9 10  12 13  15  17  19  21  23\n" +
+				"├─┾━┿━┽─╂─┼─┼─┼─┼─┼─┼─┤├─┼─┼─┼─┼─┼─┼─┼─┼─┼─┼─┼─┤",
+		},
+		{
+			name:       "berlin, 25 hours",
+			resolution: time.Hour,
+			loc:        berlin,
+			day:        time.Date(2022, 10, 30, 0, 0, 0, 0, berlin),
+			expected: "0 1 2 2 3 4 5 6 7 8 9 10  12 13  15  17  19  21  23\n" +
+				"├─┾━┿━┿━┿━┽─╂─┼─┼─┼─┼─┼─┼─┤├─┼─┼─┼─┼─┼─┼─┼─┼─┼─┼─┼─┤",
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			day, err := NewIncrementFormatter(NewUnicodeChar, tc.resolution, WithHeader(time.Hour), WithLocation(tc.loc))
+			assert.NoError(t, err)
+			f := NewMultiDayFormatter(day, tc.day, tc.day.Add(time.Hour))
+			y, m, d := tc.day.Date()
+			schedules := []Schedule{
+				{time.Date(y, m, d, 1, 0, 0, 0, tc.loc), time.Date(y, m, d, 4, 0, 0, 0, tc.loc)},
+			}
+
+			got := f.FormatWithTime(schedules, time.Date(y, m, d, 5, 0, 0, 0, tc.loc))
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestMultiDayFormatter_tooManyDays(t *testing.T) {
+	day := NewHalfHourIncrementFormatter(NewUnicodeChar).IncrementFormatter
+	cases := []struct {
+		name     string
+		from     time.Time
+		to       time.Time
+		expected int
+		err      error
+	}{
+		{
+			name:     "a leap year",
+			from:     date(2020, 1, 1, 0, 0),
+			to:       date(2021, 1, 1, 0, 0),
+			expected: 366,
+		},
+		{
+			name:     "from the zero time",
+			from:     time.Time{},
+			to:       date(2022, 2, 1, 0, 0),
+			expected: 366,
+			err:      ErrTooManyDays,
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			f := NewMultiDayFormatter(day, tc.from, tc.to)
+
+			got, err := f.FormatChecked(nil)
+			assert.ErrorIs(t, err, tc.err)
+			if tc.err == nil {
+				assert.Equal(t, tc.expected, strings.Count(got, "\n")+1)
+			}
+			assert.Len(t, f.days(), tc.expected)
+		})
+	}
+}
+
+func date(y int, m time.Month, d, h, min int) time.Time {
+	return time.Date(y, m, d, h, min, 0, 0, time.UTC)
+}
@@ -0,0 +1,100 @@
+package timechart
+
+import (
+	"sort"
+	"time"
+)
+
+// Option configures an IncrementFormatter.
+type Option func(*IncrementFormatter)
+
+// WithHeader makes a formatter put a line labeling hours above a chart, one label every given hours.
+// e.g. WithHeader(3 * time.Hour) labels 0, 3, 6, 9, 12, … where each tick lies.
+// Labels which would overlap their previous one are left out.
+// Hours are labeled in whole hours only, so every is truncated to them, e.g. 90 minutes to an hour,
+// and is an hour at least.
+func WithHeader(every time.Duration) Option {
+	return func(f *IncrementFormatter) {
+		every = every.Truncate(time.Hour)
+		if every < time.Hour {
+			every = time.Hour
+		}
+		f.header = every
+	}
+}
+
+// WithLocation makes a formatter draw schedules and now in the time zone of loc,
+// so that a schedule made in New York is drawn where it lies in Seoul.
+// Times of day made by NewTime are drawn as they are.
+// A day of a daylight saving time transition in loc is drawn for the 23 or 25 hours it lasts,
+// if it's the day of now or else of the first schedule of instants.
+func WithLocation(loc *time.Location) Option {
+	return func(f *IncrementFormatter) {
+		f.loc = loc
+	}
+}
+
+// WithWindow makes a formatter draw only the times of day in [from, to), like working hours,
+// e.g. WithWindow(8*time.Hour, 20*time.Hour). Schedules are clipped to the window,
+// and its edges are drawn cropped where they go on beyond, by charsets implementing CroppedChar.
+// Now is marked only within the window.
+// The window must lie within a day, 0 <= from < to <= 24 hours, so it can't go over midnight.
+// NewIncrementFormatter returns ErrInvalidWindow for an invalid window,
+// which formatters made otherwise ignore, drawing the whole day or the window of a previous WithWindow.
+func WithWindow(from, to time.Duration) Option {
+	return func(f *IncrementFormatter) {
+		if from < 0 || to > 24*time.Hour || from >= to {
+			f.err = ErrInvalidWindow
+			return
+		}
+		f.from, f.to = from, to
+	}
+}
+
+// WithPartialSlots makes a formatter draw slots which schedules cover only partly,
+// like a meeting from 10:10 to 10:20 on a chart of 30 minutes slots.
+// Charsets implementing PartialChar draw how much of them is covered, others draw them filled.
+func WithPartialSlots() Option {
+	return func(f *IncrementFormatter) {
+		f.partial = true
+	}
+}
+
+// WithSplits makes a formatter split a day at the given times of day instead of noon,
+// e.g. WithSplits(7*time.Hour, 15*time.Hour, 23*time.Hour) for shift changes.
+// WithSplits() draws a day in a single segment. Times not within a day are left out.
+func WithSplits(splits ...time.Duration) Option {
+	return func(f *IncrementFormatter) {
+		var valid []time.Duration
+		for _, split := range splits {
+			if split > 0 && split < 24*time.Hour {
+				valid = append(valid, split)
+			}
+		}
+		sort.Slice(valid, func(i, j int) bool {
+			return valid[i] < valid[j]
+		})
+		f.splits = nil
+		for i, split := range valid {
+			if i == 0 || split != valid[i-1] {
+				f.splits = append(f.splits, split)
+			}
+		}
+	}
+}
+
+// WithSplitEvery makes a formatter split a day every given duration instead of at noon,
+// e.g. WithSplitEvery(6 * time.Hour) splits it at 06:00, 12:00 and 18:00.
+// A duration under a minute or the resolution of the formatter, like a unitless 6, is ignored.
+func WithSplitEvery(every time.Duration) Option {
+	return func(f *IncrementFormatter) {
+		if every < time.Minute || every < f.resolution {
+			return
+		}
+		var splits []time.Duration
+		for split := every; split < 24*time.Hour; split += every {
+			splits = append(splits, split)
+		}
+		WithSplits(splits...)(f)
+	}
+}
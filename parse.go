@@ -0,0 +1,212 @@
+package timechart
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// ErrInvalidChart is returned when a chart can't be parsed.
+var ErrInvalidChart = errors.New("timechart: invalid chart")
+
+// ansiEscape matches the escape sequences of ColorChar.
+var ansiEscape = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
+// Parse reads schedules back from a chart drawn by f, with or without its header and colors.
+// Schedules are times of day as made by NewTime, one for each run of filled cells,
+// and one ending at midnight ends on the next day.
+// Where a run shows a schedule starts or ends within a slot, it's read as the middle of the slot,
+// so the schedules are drawn back into the same chart unless the slots are drawn partly filled.
+// A run cut off by a window is read as going on to the start or end of the day.
+func (f IncrementFormatter) Parse(chart string) ([]Schedule, error) {
+	line, err := chartLine(chart)
+	if err != nil {
+		return nil, err
+	}
+	l := f.layout()
+	glyphs := []rune(line)
+	if len(glyphs) != len(l) {
+		return nil, fmt.Errorf("%w: %d columns, not %d", ErrInvalidChart, len(glyphs), len(l))
+	}
+
+	table := glyphTable(f.fn)
+	gs := make([]glyph, len(l))
+	for i, c := range l {
+		g, ok := table[c.t][string(glyphs[i])]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown glyph %q at column %d", ErrInvalidChart, glyphs[i], i+1)
+		}
+		gs[i] = g
+	}
+
+	var ss []Schedule
+	run := func(s, e int) {
+		schedule := l.runSchedule(s, e)
+		if gs[s].cropped {
+			schedule.Start = NewTime(0, 0, 0)
+		}
+		if gs[e-1].cropped {
+			schedule.End = NewTime(0, 0, 0).AddDate(0, 0, 1)
+		}
+		ss = append(ss, schedule)
+	}
+	s := -1 // the first cell of a run of filled cells
+	for i, g := range gs {
+		switch {
+		case g.filled && s < 0:
+			s = i
+		case !g.filled && s >= 0:
+			run(s, i)
+			s = -1
+		}
+	}
+	if s >= 0 {
+		run(s, len(l))
+	}
+	return ss, nil
+}
+
+// runSchedule returns a schedule drawn as the filled cells [s, e) of l.
+// A slot next to an empty hour or edge is filled for a part of it only,
+// so the schedule starts or ends in the middle of the slot.
+// Likewise an edge opening a segment is filled for a schedule ending after it,
+// so a run ending on it ends in the middle of the slot next to it.
+func (l layout) runSchedule(s, e int) Schedule {
+	start, end := l[s].from, l[e-1].to
+	if l[s].t == slot && s > 0 && l[s-1].t != slot {
+		start = l[s].middle()
+	}
+	switch {
+	case l[e-1].t == slot && e < len(l) && l[e].t != slot:
+		end = l[e-1].middle()
+	case l[e-1].t == edge && l[e-1].start && e < len(l) && l[e].t == slot:
+		end = l[e].middle()
+	}
+	return NewSchedule(start, end)
+}
+
+// middle returns the middle of c.
+func (c cell) middle() time.Time {
+	return c.from.Add(c.to.Sub(c.from) / 2)
+}
+
+// Parse reads schedules back from a chart of a whole day drawn by a charset of this package,
+// split at noon or not, as IncrementFormatter.Parse does.
+// Of resolutions drawing charts of the same length, the finest is taken.
+func Parse(chart string) ([]Schedule, error) {
+	for _, resolution := range fitResolutions {
+		for _, split := range []Option{WithSplits(12 * time.Hour), WithSplits()} {
+			for _, charset := range []func() Char{NewUnicodeChar, NewASCIIChar} {
+				f := newIncrementFormatter(charset, resolution, []Option{split})
+				if ss, err := f.Parse(chart); err == nil {
+					return ss, nil
+				}
+			}
+		}
+	}
+	return nil, ErrInvalidChart
+}
+
+// chartLine returns the line of chart drawing schedules, without colors.
+func chartLine(chart string) (string, error) {
+	lines := strings.Split(strings.TrimRight(ansiEscape.ReplaceAllString(chart, ""), "\n"), "\n")
+	switch len(lines) {
+	case 1:
+		return lines[0], nil
+	case 2:
+		return lines[1], nil // under a header
+	default:
+		return "", fmt.Errorf("%w: %d lines", ErrInvalidChart, len(lines))
+	}
+}
+
+// glyph is what a glyph drawn for a cell shows.
+type glyph struct {
+	filled  bool
+	cropped bool // cut off by a window
+}
+
+// glyphTable returns what each glyph fn draws shows, by the type of the cell.
+func glyphTable(fn func() Char) map[charType]map[string]glyph {
+	table := map[charType]map[string]glyph{
+		hour: {},
+		edge: {},
+		slot: {},
+	}
+	for _, t := range []charType{hour, edge, slot} {
+		for _, c := range charVariants(fn, t) {
+			s := ansiEscape.ReplaceAllString(c.String(), "")
+			g := table[t][s]
+			table[t][s] = glyph{filled: g.filled || c.filled, cropped: g.cropped || c.cropped}
+		}
+	}
+	return table
+}
+
+type charVariant struct {
+	Char
+	filled  bool
+	cropped bool
+}
+
+// charVariants returns every Char fn draws for a cell of t.
+func charVariants(fn func() Char, t charType) []charVariant {
+	base := fn()
+	switch t {
+	case hour:
+		base = base.Hour()
+	case edge:
+		base = base.Edge()
+	case slot:
+		base = base.Slot()
+	}
+
+	variants := []charVariant{{Char: base}}
+	grow := func(fn func(charVariant) []charVariant) {
+		var grown []charVariant
+		for _, v := range variants {
+			grown = append(grown, fn(v)...)
+		}
+		variants = grown
+	}
+	grow(func(v charVariant) []charVariant {
+		return []charVariant{v, {Char: v.Start(), filled: v.filled}}
+	})
+	grow(func(v charVariant) []charVariant {
+		return []charVariant{v, {Char: v.End(), filled: v.filled}}
+	})
+	grow(func(v charVariant) []charVariant {
+		return []charVariant{v, {Char: v.Fill(), filled: true}}
+	})
+	grow(func(v charVariant) []charVariant {
+		vs := []charVariant{v}
+		if cc, ok := v.Char.(CategoryChar); ok && v.filled {
+			for _, category := range []Category{Busy, Tentative, OutOfOffice} {
+				vs = append(vs, charVariant{Char: cc.Category(category), filled: true})
+			}
+		}
+		return vs
+	})
+	grow(func(v charVariant) []charVariant {
+		vs := []charVariant{v}
+		if pc, ok := v.Char.(PartialChar); ok && v.filled {
+			for _, part := range [][2]float64{{0, 0.5}, {0.5, 1}, {0.25, 0.75}} {
+				vs = append(vs, charVariant{Char: pc.Partial(part[0], part[1]), filled: true})
+			}
+		}
+		return vs
+	})
+	grow(func(v charVariant) []charVariant {
+		vs := []charVariant{v}
+		if cc, ok := v.Char.(CroppedChar); ok && v.filled && t == edge {
+			vs = append(vs, charVariant{Char: cc.Cropped(), filled: true, cropped: true})
+		}
+		return vs
+	})
+	grow(func(v charVariant) []charVariant {
+		return []charVariant{v, {Char: v.Now(), filled: v.filled, cropped: v.cropped}}
+	})
+	return variants
+}
@@ -0,0 +1,134 @@
+package timechart
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIncrementFormatter_Parse(t *testing.T) {
+	schedules := []Schedule{
+		{newTime(0, 0), newTime(1, 0)},
+		{newTime(9, 0), newTime(12, 0)},
+		{newTime(13, 30), newTime(14, 0)},
+		{newTime(15, 0), newTime(18, 20)}, // ends on ┝ when split at 18:00
+		{newTime(22, 0), newTime(24, 0)},
+	}
+	hourly, err := NewIncrementFormatter(NewASCIIChar, time.Hour)
+	assert.NoError(t, err)
+
+	cases := []struct {
+		name string
+		f    IncrementFormatter
+	}{
+		{
+			name: "unicode",
+			f:    NewHalfHourIncrementFormatter(NewUnicodeChar).IncrementFormatter,
+		},
+		{
+			name: "ascii",
+			f:    NewHalfHourIncrementFormatter(NewASCIIChar).IncrementFormatter,
+		},
+		{
+			name: "colors",
+			f:    NewHalfHourIncrementFormatter(NewColorCharset(NewUnicodeChar, DefaultPalette)).IncrementFormatter,
+		},
+		{
+			name: "header",
+			f:    NewHalfHourIncrementFormatter(NewUnicodeChar, WithHeader(3*time.Hour)).IncrementFormatter,
+		},
+		{
+			name: "splits",
+			f:    NewHalfHourIncrementFormatter(NewUnicodeChar, WithSplitEvery(6*time.Hour)).IncrementFormatter,
+		},
+		{
+			name: "window",
+			f:    NewHalfHourIncrementFormatter(NewUnicodeChar, WithWindow(10*time.Hour, 16*time.Hour)).IncrementFormatter,
+		},
+		{
+			name: "ascii window",
+			f:    NewHalfHourIncrementFormatter(NewASCIIChar, WithWindow(10*time.Hour, 16*time.Hour)).IncrementFormatter,
+		},
+		{
+			name: "hourly",
+			f:    hourly,
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			chart := tc.f.FormatWithTime(schedules, time.Date(2022, 2, 1, 10, 0, 0, 0, time.UTC))
+
+			got, err := tc.f.Parse(chart)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.f.Format(schedules), tc.f.Format(got))
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		name     string
+		chart    string
+		expected []Schedule
+		err      error
+	}{
+		{
+			name:  "unicode",
+			chart: "├──┼──┼──┼──┼──┼──┼──┼──┼──┾━━╋━━┿━━┥┝━━┽──┼──┼──┼──┼──┼──┼──┼──┼──┾━━┿━━┥",
+			expected: []Schedule{
+				{newTime(9, 0), newTime(13, 0)},
+				{newTime(22, 0), newTime(24, 0)},
+			},
+		},
+		{
+			name:  "ascii",
+			chart: "|--+--+--+--+--+--+--+--+--#==@==#==][==#--+--+--+--+--+--+--+--+--#==#==]",
+			expected: []Schedule{
+				{newTime(9, 0), newTime(13, 0)},
+				{newTime(22, 0), newTime(24, 0)},
+			},
+		},
+		{
+			name:  "partial slots",
+			chart: "├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼╺━┽──┤├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤",
+			expected: []Schedule{
+				{newTime(10, 15), newTime(11, 0)},
+			},
+		},
+		{
+			name:  "an hour each",
+			chart: "├─┼─┼─┼─┼─┼─┼─┼─┼─┾━┽─┼─┼─┼─┼─┼─┼─┼─┼─┼─┼─┼─┼─┼─┤",
+			expected: []Schedule{
+				{newTime(9, 0), newTime(10, 0)},
+			},
+		},
+		{
+			name:  "empty",
+			chart: "├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤",
+		},
+		{
+			name:  "unknown glyph",
+			chart: "├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┤├──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──┼──X",
+			err:   ErrInvalidChart,
+		},
+		{
+			name:  "no chart",
+			chart: "lunch at noon",
+			err:   ErrInvalidChart,
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Parse(tc.chart)
+			assert.ErrorIs(t, err, tc.err)
+			assert.Equal(t, len(tc.expected), len(got))
+			for i := range tc.expected {
+				assert.True(t, tc.expected[i].Start.Equal(got[i].Start), "start of %d", i)
+				assert.True(t, tc.expected[i].End.Equal(got[i].End), "end of %d", i)
+			}
+		})
+	}
+}
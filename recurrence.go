@@ -0,0 +1,250 @@
+package timechart
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidRRule is returned when a recurrence rule can't be parsed.
+var ErrInvalidRRule = errors.New("timechart: invalid RRULE")
+
+// Frequency is how often a Recurrence repeats.
+type Frequency uint8
+
+const (
+	Daily Frequency = iota
+	Weekly
+	Monthly
+	Yearly
+)
+
+func (f Frequency) String() string {
+	switch f {
+	case Daily:
+		return "DAILY"
+	case Weekly:
+		return "WEEKLY"
+	case Monthly:
+		return "MONTHLY"
+	case Yearly:
+		return "YEARLY"
+	default:
+		return "unknown"
+	}
+}
+
+// Recurrence is a schedule repeating like an RRULE of iCalendar (RFC 5545),
+// e.g. a daily stand-up or a shift from Monday to Friday.
+// Occurrences start at the wall clock time of the first one and last as long as it.
+// The first occurrence is always one, as DTSTART is, even on a day the rule doesn't repeat on.
+type Recurrence struct {
+	First Schedule `json:"first"` // the first occurrence
+
+	Freq Frequency `json:"freq"`
+	// Interval repeats every Interval days, weeks, months or years, or every one if 0.
+	Interval int `json:"interval,omitempty"`
+	// ByDay repeats on the given days of the week only.
+	// Weekly, monthly and yearly recurrences repeat on every one of them in each period.
+	ByDay []time.Weekday `json:"byDay,omitempty"`
+	// Count stops after Count occurrences including the first and ExDates, unless 0.
+	Count int `json:"count,omitempty"`
+	// Until stops before occurrences starting after Until, unless zero.
+	Until time.Time `json:"until"`
+	// ExDates are the starts of occurrences left out.
+	ExDates []time.Time `json:"exDates,omitempty"`
+}
+
+// NewRecurrence returns a Recurrence of which the first occurrence is s,
+// repeating as rrule, e.g. "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR;COUNT=10", except at exdates.
+// An UNTIL without Z is in the location of s.
+// It returns an error wrapping ErrInvalidRRule if rrule has parts other than
+// FREQ, INTERVAL, BYDAY, COUNT, UNTIL and WKST, or days with an ordinal like 1MO.
+func NewRecurrence(s Schedule, rrule string, exdates ...time.Time) (Recurrence, error) {
+	r := Recurrence{First: s, ExDates: exdates}
+	invalid := func(format string, args ...interface{}) error {
+		return fmt.Errorf("%w %q: %s", ErrInvalidRRule, rrule, fmt.Sprintf(format, args...))
+	}
+
+	freq := false
+	for _, part := range strings.Split(strings.TrimPrefix(rrule, "RRULE:"), ";") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			return Recurrence{}, invalid("%q isn't a pair", part)
+		}
+		key, value := strings.ToUpper(kv[0]), strings.ToUpper(kv[1])
+		var err error
+		switch key {
+		case "FREQ":
+			r.Freq, err = parseFrequency(value)
+			freq = true
+		case "INTERVAL":
+			r.Interval, err = strconv.Atoi(value)
+			if err == nil && r.Interval < 1 {
+				err = errors.New("not positive")
+			}
+		case "BYDAY":
+			r.ByDay, err = parseWeekdays(value)
+		case "COUNT":
+			r.Count, err = strconv.Atoi(value)
+			if err == nil && r.Count < 1 {
+				err = errors.New("not positive")
+			}
+		case "UNTIL":
+			r.Until, err = parseUntil(value, s.Start.Location())
+		case "WKST":
+			if value != "MO" {
+				err = errors.New("weeks start on Monday only")
+			}
+		default:
+			err = errors.New("unsupported")
+		}
+		if err != nil {
+			return Recurrence{}, invalid("%s: %s", key, err)
+		}
+	}
+	if !freq {
+		return Recurrence{}, invalid("missing FREQ")
+	}
+	return r, nil
+}
+
+func parseFrequency(s string) (Frequency, error) {
+	for _, f := range []Frequency{Daily, Weekly, Monthly, Yearly} {
+		if s == f.String() {
+			return f, nil
+		}
+	}
+	return 0, fmt.Errorf("unsupported %q", s)
+}
+
+func parseWeekdays(s string) ([]time.Weekday, error) {
+	var days []time.Weekday
+	for _, name := range strings.Split(s, ",") {
+		day, ok := rruleWeekdays[name]
+		if !ok {
+			return nil, fmt.Errorf("unsupported day %q", name)
+		}
+		days = append(days, day)
+	}
+	return days, nil
+}
+
+// Expand returns the occurrences of r overlapping window, sorted by their start.
+// An occurrence lasting for no time is returned if it starts within [window.Start, window.End).
+func (r Recurrence) Expand(window Schedule) []Schedule {
+	var ss []Schedule
+	d := r.First.End.Sub(r.First.Start)
+	add := func(start time.Time) {
+		if r.excluded(start) {
+			return
+		}
+		end := start.Add(d)
+		if timeLT(start, window.End) && (timeGT(end, window.Start) || timeGTE(start, window.Start)) {
+			ss = append(ss, NewSchedule(start, end))
+		}
+	}
+
+	add(r.First.Start) // even if it doesn't repeat by the rule, as DTSTART
+	n := 1
+	for period := 0; ; period++ {
+		days, from := r.days(period)
+		if r.stopsBefore(from, window) {
+			return ss
+		}
+		for _, day := range days {
+			start := r.at(day)
+			if timeLTE(start, r.First.Start) {
+				continue // the first occurrence or before it
+			}
+			if r.stopsBefore(day, window) || r.Count > 0 && n >= r.Count {
+				return ss
+			}
+			n++
+			add(start)
+		}
+	}
+}
+
+// stopsBefore returns whether no occurrence on day or later is expanded into window.
+func (r Recurrence) stopsBefore(day time.Time, window Schedule) bool {
+	start := r.at(day)
+	return timeGTE(start, window.End) || !r.Until.IsZero() && timeGT(start, r.Until)
+}
+
+// at returns the start of an occurrence on day.
+func (r Recurrence) at(day time.Time) time.Time {
+	y, m, d := day.Date()
+	s := r.First.Start
+	return time.Date(y, m, d, s.Hour(), s.Minute(), s.Second(), s.Nanosecond(), s.Location())
+}
+
+func (r Recurrence) excluded(start time.Time) bool {
+	for _, ex := range r.ExDates {
+		if ex.Equal(start) {
+			return true
+		}
+	}
+	return false
+}
+
+// days returns the midnights of the days of the nth period to repeat on, and the first day of the period.
+// A period may have no days, like February for a monthly recurrence on the 30th.
+func (r Recurrence) days(n int) ([]time.Time, time.Time) {
+	interval := r.Interval
+	if interval < 1 {
+		interval = 1
+	}
+	k := n * interval
+	y, m, d := r.First.Start.Date()
+	loc := r.First.Start.Location()
+
+	var from, to time.Time // [from, to) of the period
+	switch r.Freq {
+	case Weekly:
+		monday := d - (int(r.First.Start.Weekday())+6)%7
+		from = time.Date(y, m, monday+7*k, 0, 0, 0, 0, loc)
+		to = from.AddDate(0, 0, 7)
+	case Monthly:
+		from = time.Date(y, m+time.Month(k), 1, 0, 0, 0, 0, loc)
+		to = from.AddDate(0, 1, 0)
+	case Yearly:
+		from = time.Date(y+k, 1, 1, 0, 0, 0, 0, loc)
+		to = from.AddDate(1, 0, 0)
+	default:
+		from = time.Date(y, m, d+k, 0, 0, 0, 0, loc)
+		to = from.AddDate(0, 0, 1)
+	}
+
+	var days []time.Time
+	for day := from; timeLT(day, to); day = day.AddDate(0, 0, 1) {
+		if r.repeatsOn(day) {
+			days = append(days, day)
+		}
+	}
+	return days, from
+}
+
+// repeatsOn returns whether day of a period of r has an occurrence.
+func (r Recurrence) repeatsOn(day time.Time) bool {
+	if len(r.ByDay) > 0 {
+		for _, weekday := range r.ByDay {
+			if day.Weekday() == weekday {
+				return true
+			}
+		}
+		return false
+	}
+	switch r.Freq {
+	case Weekly:
+		return day.Weekday() == r.First.Start.Weekday()
+	case Monthly:
+		return day.Day() == r.First.Start.Day()
+	case Yearly:
+		return day.Month() == r.First.Start.Month() && day.Day() == r.First.Start.Day()
+	default:
+		return true
+	}
+}
@@ -0,0 +1,238 @@
+package timechart
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecurrence_Expand(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+
+	// 2022-03-01 is a Tuesday.
+	standup := Schedule{date(2022, 3, 1, 9, 0), date(2022, 3, 1, 9, 15)}
+	march := Schedule{date(2022, 3, 1, 0, 0), date(2022, 4, 1, 0, 0)}
+
+	cases := []struct {
+		name       string
+		recurrence Recurrence
+		window     Schedule
+		expected   []Schedule
+	}{
+		{
+			name:       "daily",
+			recurrence: Recurrence{First: standup, Freq: Daily},
+			window:     Schedule{date(2022, 3, 3, 0, 0), date(2022, 3, 5, 0, 0)},
+			expected: []Schedule{
+				{date(2022, 3, 3, 9, 0), date(2022, 3, 3, 9, 15)},
+				{date(2022, 3, 4, 9, 0), date(2022, 3, 4, 9, 15)},
+			},
+		},
+		{
+			name:       "every other day",
+			recurrence: Recurrence{First: standup, Freq: Daily, Interval: 2},
+			window:     Schedule{date(2022, 3, 1, 0, 0), date(2022, 3, 6, 0, 0)},
+			expected: []Schedule{
+				{date(2022, 3, 1, 9, 0), date(2022, 3, 1, 9, 15)},
+				{date(2022, 3, 3, 9, 0), date(2022, 3, 3, 9, 15)},
+				{date(2022, 3, 5, 9, 0), date(2022, 3, 5, 9, 15)},
+			},
+		},
+		{
+			name: "weekdays",
+			recurrence: Recurrence{
+				First: Schedule{date(2022, 3, 1, 9, 0), date(2022, 3, 1, 18, 0)},
+				Freq:  Weekly,
+				ByDay: []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+			},
+			window: Schedule{date(2022, 3, 4, 12, 0), date(2022, 3, 8, 0, 0)},
+			expected: []Schedule{
+				{date(2022, 3, 4, 9, 0), date(2022, 3, 4, 18, 0)},
+				{date(2022, 3, 7, 9, 0), date(2022, 3, 7, 18, 0)},
+			},
+		},
+		{
+			name:       "count",
+			recurrence: Recurrence{First: standup, Freq: Weekly, Count: 3},
+			window:     march,
+			expected: []Schedule{
+				{date(2022, 3, 1, 9, 0), date(2022, 3, 1, 9, 15)},
+				{date(2022, 3, 8, 9, 0), date(2022, 3, 8, 9, 15)},
+				{date(2022, 3, 15, 9, 0), date(2022, 3, 15, 9, 15)},
+			},
+		},
+		{
+			name: "first off the days",
+			recurrence: Recurrence{
+				First: Schedule{date(2022, 2, 1, 9, 0), date(2022, 2, 1, 9, 15)}, // a Tuesday
+				Freq:  Weekly,
+				ByDay: []time.Weekday{time.Monday, time.Wednesday, time.Friday},
+				Count: 5,
+			},
+			window: Schedule{date(2022, 2, 1, 0, 0), date(2022, 3, 1, 0, 0)},
+			expected: []Schedule{
+				{date(2022, 2, 1, 9, 0), date(2022, 2, 1, 9, 15)},
+				{date(2022, 2, 2, 9, 0), date(2022, 2, 2, 9, 15)},
+				{date(2022, 2, 4, 9, 0), date(2022, 2, 4, 9, 15)},
+				{date(2022, 2, 7, 9, 0), date(2022, 2, 7, 9, 15)},
+				{date(2022, 2, 9, 9, 0), date(2022, 2, 9, 9, 15)},
+			},
+		},
+		{
+			name:       "until",
+			recurrence: Recurrence{First: standup, Freq: Weekly, Until: date(2022, 3, 15, 9, 0)},
+			window:     march,
+			expected: []Schedule{
+				{date(2022, 3, 1, 9, 0), date(2022, 3, 1, 9, 15)},
+				{date(2022, 3, 8, 9, 0), date(2022, 3, 8, 9, 15)},
+				{date(2022, 3, 15, 9, 0), date(2022, 3, 15, 9, 15)},
+			},
+		},
+		{
+			name: "exdates",
+			recurrence: Recurrence{
+				First:   standup,
+				Freq:    Weekly,
+				Count:   3,
+				ExDates: []time.Time{date(2022, 3, 8, 9, 0)},
+			},
+			window: march,
+			expected: []Schedule{
+				{date(2022, 3, 1, 9, 0), date(2022, 3, 1, 9, 15)},
+				{date(2022, 3, 15, 9, 0), date(2022, 3, 15, 9, 15)},
+			},
+		},
+		{
+			name:       "monthly on the 31st",
+			recurrence: Recurrence{First: Schedule{date(2022, 1, 31, 9, 0), date(2022, 1, 31, 10, 0)}, Freq: Monthly},
+			window:     Schedule{date(2022, 1, 1, 0, 0), date(2022, 6, 1, 0, 0)},
+			expected: []Schedule{
+				{date(2022, 1, 31, 9, 0), date(2022, 1, 31, 10, 0)},
+				{date(2022, 3, 31, 9, 0), date(2022, 3, 31, 10, 0)},
+				{date(2022, 5, 31, 9, 0), date(2022, 5, 31, 10, 0)},
+			},
+		},
+		{
+			name:       "yearly",
+			recurrence: Recurrence{First: Schedule{date(2020, 2, 29, 0, 0), date(2020, 3, 1, 0, 0)}, Freq: Yearly},
+			window:     Schedule{date(2020, 1, 1, 0, 0), date(2025, 1, 1, 0, 0)},
+			expected: []Schedule{
+				{date(2020, 2, 29, 0, 0), date(2020, 3, 1, 0, 0)},
+				{date(2024, 2, 29, 0, 0), date(2024, 3, 1, 0, 0)},
+			},
+		},
+		{
+			name:       "overlapping the window",
+			recurrence: Recurrence{First: Schedule{date(2022, 3, 1, 22, 0), date(2022, 3, 2, 2, 0)}, Freq: Daily},
+			window:     Schedule{date(2022, 3, 3, 0, 0), date(2022, 3, 4, 0, 0)},
+			expected: []Schedule{
+				{date(2022, 3, 2, 22, 0), date(2022, 3, 3, 2, 0)},
+				{date(2022, 3, 3, 22, 0), date(2022, 3, 4, 2, 0)},
+			},
+		},
+		{
+			name: "wall clock",
+			recurrence: Recurrence{
+				First: Schedule{time.Date(2022, 3, 12, 9, 0, 0, 0, newYork), time.Date(2022, 3, 12, 10, 0, 0, 0, newYork)},
+				Freq:  Daily,
+			},
+			window: Schedule{time.Date(2022, 3, 13, 0, 0, 0, 0, newYork), time.Date(2022, 3, 14, 0, 0, 0, 0, newYork)},
+			expected: []Schedule{
+				{time.Date(2022, 3, 13, 9, 0, 0, 0, newYork), time.Date(2022, 3, 13, 10, 0, 0, 0, newYork)},
+			},
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got := tc.recurrence.Expand(tc.window)
+			assert.Equal(t, len(tc.expected), len(got))
+			for i := range tc.expected {
+				if i < len(got) {
+					assert.True(t, tc.expected[i].Start.Equal(got[i].Start), "start of %d: %s", i, got[i].Start)
+					assert.True(t, tc.expected[i].End.Equal(got[i].End), "end of %d: %s", i, got[i].End)
+				}
+			}
+		})
+	}
+}
+
+func TestRecurrence_MarshalJSON(t *testing.T) {
+	r := Recurrence{
+		First:    Schedule{date(2022, 3, 1, 9, 0), date(2022, 3, 1, 9, 15)},
+		Freq:     Weekly,
+		Interval: 2,
+		ByDay:    []time.Weekday{time.Monday, time.Friday},
+		Count:    10,
+		Until:    date(2022, 6, 1, 0, 0),
+		ExDates:  []time.Time{date(2022, 3, 4, 9, 0)},
+	}
+
+	data, err := json.Marshal(r)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"first":{"start":"2022-03-01T09:00:00Z","end":"2022-03-01T09:15:00Z"},"freq":"WEEKLY",`+
+		`"interval":2,"byDay":[1,5],"count":10,"until":"2022-06-01T00:00:00Z","exDates":["2022-03-04T09:00:00Z"]}`, string(data))
+
+	var got Recurrence
+	assert.NoError(t, json.Unmarshal(data, &got))
+	assert.Equal(t, r, got)
+
+	assert.Contains(t, fmt.Sprint(r), "WEEKLY 2 [Monday Friday] 10")
+	assert.Error(t, json.Unmarshal([]byte(`{"freq":"HOURLY"}`), &Recurrence{}))
+}
+
+func TestNewRecurrence(t *testing.T) {
+	standup := Schedule{date(2022, 3, 1, 9, 0), date(2022, 3, 1, 9, 15)}
+	cases := []struct {
+		rrule    string
+		expected Recurrence
+		err      error
+	}{
+		{
+			rrule: "FREQ=WEEKLY;BYDAY=MO,WE,FR;INTERVAL=2;COUNT=10",
+			expected: Recurrence{
+				First:    standup,
+				Freq:     Weekly,
+				Interval: 2,
+				ByDay:    []time.Weekday{time.Monday, time.Wednesday, time.Friday},
+				Count:    10,
+			},
+		},
+		{
+			rrule: "RRULE:FREQ=DAILY;UNTIL=20220310T000000Z;WKST=MO",
+			expected: Recurrence{
+				First: standup,
+				Freq:  Daily,
+				Until: time.Date(2022, 3, 10, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			rrule: "FREQ=MONTHLY;UNTIL=20221231",
+			expected: Recurrence{
+				First: standup,
+				Freq:  Monthly,
+				Until: time.Date(2022, 12, 31, 23, 59, 59, 999999999, time.UTC),
+			},
+		},
+		{rrule: "BYDAY=MO", err: ErrInvalidRRule},
+		{rrule: "FREQ=HOURLY", err: ErrInvalidRRule},
+		{rrule: "FREQ=WEEKLY;BYDAY=1MO", err: ErrInvalidRRule},
+		{rrule: "FREQ=DAILY;COUNT=0", err: ErrInvalidRRule},
+		{rrule: "FREQ=DAILY;BYSETPOS=1", err: ErrInvalidRRule},
+		{rrule: "FREQ=DAILY;UNTIL=tomorrow", err: ErrInvalidRRule},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.rrule, func(t *testing.T) {
+			got, err := NewRecurrence(standup, tc.rrule)
+			assert.ErrorIs(t, err, tc.err)
+			if tc.err == nil {
+				assert.Equal(t, tc.expected, got)
+			}
+		})
+	}
+}
@@ -1,10 +1,40 @@
 package timechart
 
 import (
+	"errors"
 	"fmt"
+	"sort"
 	"time"
 )
 
+var (
+	// ErrEndBeforeStart is returned when a schedule ends before it starts.
+	ErrEndBeforeStart = errors.New("timechart: schedule ends before it starts")
+	// ErrOutOfRange is returned when the length of a schedule can't be represented as a time.Duration,
+	// e.g. a calendar date paired with a time of day.
+	ErrOutOfRange = errors.New("timechart: schedule is out of range")
+	// ErrZeroTime is returned when a schedule starts or ends at the zero time, as if it's not set.
+	// A schedule of times of day may start at the zero time, which is the midnight made by NewTime.
+	ErrZeroTime = errors.New("timechart: schedule has a zero time")
+	// ErrLocationMismatch is returned when a schedule starts and ends in different locations.
+	ErrLocationMismatch = errors.New("timechart: schedule starts and ends in different locations")
+)
+
+// ScheduleError records an invalid schedule and why it is invalid.
+type ScheduleError struct {
+	Index    int // position of Schedule in the given schedules
+	Schedule Schedule
+	Err      error
+}
+
+func (e *ScheduleError) Error() string {
+	return fmt.Sprintf("%s: #%d %s", e.Err, e.Index, e.Schedule)
+}
+
+func (e *ScheduleError) Unwrap() error {
+	return e.Err
+}
+
 type Schedule struct {
 	Start time.Time
 	End   time.Time
@@ -17,6 +47,41 @@ func NewSchedule(start, end time.Time) Schedule {
 	}
 }
 
+// NewValidSchedule returns a new schedule like NewSchedule, or an error if it is invalid.
+func NewValidSchedule(start, end time.Time) (Schedule, error) {
+	s := NewSchedule(start, end)
+	if err := s.Validate(); err != nil {
+		return Schedule{}, err
+	}
+	return s, nil
+}
+
+// Validate returns ErrZeroTime, ErrLocationMismatch, ErrEndBeforeStart or ErrOutOfRange if s can't be drawn.
+func (s Schedule) Validate() error {
+	switch {
+	case s.End.IsZero(), s.Start.IsZero() && !isTimeOfDay(s.End):
+		return ErrZeroTime
+	case s.Start.Location().String() != s.End.Location().String():
+		return ErrLocationMismatch
+	case timeLT(s.End, s.Start):
+		return ErrEndBeforeStart
+	case !s.Start.Add(s.End.Sub(s.Start)).Equal(s.End):
+		return ErrOutOfRange
+	default:
+		return nil
+	}
+}
+
+// ValidateSchedules returns a *ScheduleError for the first invalid schedule in ss.
+func ValidateSchedules(ss []Schedule) error {
+	for i, s := range ss {
+		if err := s.Validate(); err != nil {
+			return &ScheduleError{Index: i, Schedule: s, Err: err}
+		}
+	}
+	return nil
+}
+
 func (s Schedule) String() string {
 	return fmt.Sprintf("%s-%s", s.Start.Format("15:04"), s.End.Format("15:04"))
 }
@@ -73,35 +138,46 @@ func (s Schedule) Contains(t time.Time) bool {
 	return timeGTE(t, s.Start) && timeLTE(t, s.End)
 }
 
-// OverlapSchedules returns new overlapped schedules from given ss.
+// OverlapSchedules returns new overlapped schedules from given ss, sorted by their start.
 // e.g. if [14:00-16:00, 13:00-15:00] are given, returns [13:00-16:00]
+// Schedules touching each other are merged too, and ss is left untouched.
 func OverlapSchedules(ss []Schedule) []Schedule {
 	if len(ss) == 0 {
 		return nil
 	}
 
-	exists := ss[:1]
-	not := make([]Schedule, 0, len(ss))
-	for _, schedule := range ss[1:] {
-		found := false
-		for i, exist := range exists {
-			if exist.IsOverlapped(schedule) {
-				exists[i] = exist.Overlap(schedule)
-				found = true
-				break
-			}
+	sorted := make([]Schedule, len(ss))
+	copy(sorted, ss)
+	sort.Slice(sorted, func(i, j int) bool {
+		return timeLT(sorted[i].Start, sorted[j].Start)
+	})
+
+	merged := make([]Schedule, 0, len(sorted))
+	merged = append(merged, sorted[0])
+	for _, schedule := range sorted[1:] {
+		last := &merged[len(merged)-1]
+		if timeGT(schedule.Start, last.End) {
+			merged = append(merged, schedule)
+			continue
 		}
-		if !found {
-			not = append(not, schedule)
+		if timeGT(schedule.End, last.End) {
+			last.End = schedule.End
 		}
 	}
-	return append(exists, not...)
+	return merged
 }
 
+// NewTime returns a time of day, which has no date but the first day of year 1.
+// Formatters draw it at h:m:s regardless of their location.
 func NewTime(h, m, s int) time.Time {
 	return time.Date(1, 1, 1, h, m, s, 0, time.UTC)
 }
 
+// isTimeOfDay returns whether t is a time of day made by NewTime rather than an instant.
+func isTimeOfDay(t time.Time) bool {
+	return t.Year() == 1
+}
+
 // timeGT returns t1 is greater than t2.
 func timeGT(t1, t2 time.Time) bool {
 	return t1.After(t2)
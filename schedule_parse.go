@@ -0,0 +1,151 @@
+package timechart
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ParseError records where and why a schedule can't be parsed.
+type ParseError struct {
+	Input string
+	Pos   int // byte offset in Input
+	Msg   string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("timechart: parsing %q at %d: %s", e.Input, e.Pos, e.Msg)
+}
+
+// ParseSchedule parses a schedule of times of day made by NewTime, the inverse of Schedule.MarshalText.
+// Hours may go without minutes and a leading zero, and +N makes it end N days later.
+// e.g. "09:00-12:30", "9-17", "22:00-02:00+1" and "18:00-24:00"
+// It returns a *ParseError if s isn't a schedule or it ends before it starts.
+func ParseSchedule(s string) (Schedule, error) {
+	return parseSchedule(s, 0, len(s))
+}
+
+// ParseSchedules parses schedules separated by commas as ParseSchedule does,
+// e.g. "09:00-12:30, 13:30-18:00". It returns no schedules for a blank s.
+func ParseSchedules(s string) ([]Schedule, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+	var ss []Schedule
+	for from := 0; ; {
+		to := strings.IndexByte(s[from:], ',')
+		if to < 0 {
+			to = len(s)
+		} else {
+			to += from
+		}
+		schedule, err := parseSchedule(s, from, to)
+		if err != nil {
+			return nil, err
+		}
+		ss = append(ss, schedule)
+		if to == len(s) {
+			return ss, nil
+		}
+		from = to + 1
+	}
+}
+
+// parseSchedule parses a schedule from input[from:to], reporting errors by the position in input.
+func parseSchedule(input string, from, to int) (Schedule, error) {
+	p := &scheduleParser{input: input, pos: from, end: to}
+	p.skipSpaces()
+	if p.pos == p.end {
+		return Schedule{}, p.errorf("missing schedule")
+	}
+	start, err := p.time()
+	if err != nil {
+		return Schedule{}, err
+	}
+	p.skipSpaces()
+	if !p.consume('-') {
+		return Schedule{}, p.errorf("expected '-'")
+	}
+	p.skipSpaces()
+	endPos := p.pos
+	end, err := p.time()
+	if err != nil {
+		return Schedule{}, err
+	}
+	if p.consume('+') {
+		days, ok := p.number(3)
+		if !ok {
+			return Schedule{}, p.errorf("expected days after '+'")
+		}
+		end = end.AddDate(0, 0, days)
+	}
+	p.skipSpaces()
+	if p.pos != p.end {
+		return Schedule{}, p.errorf("unexpected %q", p.input[p.pos])
+	}
+	if timeLT(end, start) {
+		return Schedule{}, &ParseError{Input: input, Pos: endPos, Msg: "ends before it starts, add +1 to end on the next day"}
+	}
+	return NewSchedule(start, end), nil
+}
+
+type scheduleParser struct {
+	input string
+	pos   int
+	end   int
+}
+
+// time parses a time of day like 9, 09 or 09:30, where 24:00 is the midnight ending a day.
+func (p *scheduleParser) time() (time.Time, error) {
+	pos := p.pos
+	h, ok := p.number(2)
+	if !ok {
+		return time.Time{}, p.errorf("expected hour")
+	}
+	m := 0
+	if p.consume(':') {
+		mpos := p.pos
+		if m, ok = p.number(2); !ok || p.pos-mpos != 2 {
+			return time.Time{}, &ParseError{Input: p.input, Pos: mpos, Msg: "expected minutes of 2 digits"}
+		}
+		if m > 59 {
+			return time.Time{}, &ParseError{Input: p.input, Pos: mpos, Msg: fmt.Sprintf("minute %d out of range", m)}
+		}
+	}
+	if h > 24 || h == 24 && m != 0 {
+		return time.Time{}, &ParseError{Input: p.input, Pos: pos, Msg: fmt.Sprintf("%02d:%02d out of range", h, m)}
+	}
+	return NewTime(h, m, 0), nil
+}
+
+// number parses a decimal number of at most n digits.
+func (p *scheduleParser) number(n int) (int, bool) {
+	v, digits := 0, 0
+	for ; p.pos < p.end && digits < n; p.pos++ {
+		c := p.input[p.pos]
+		if c < '0' || c > '9' {
+			break
+		}
+		v = v*10 + int(c-'0')
+		digits++
+	}
+	return v, digits > 0
+}
+
+func (p *scheduleParser) consume(c byte) bool {
+	if p.pos < p.end && p.input[p.pos] == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *scheduleParser) skipSpaces() {
+	for p.pos < p.end && (p.input[p.pos] == ' ' || p.input[p.pos] == '\t') {
+		p.pos++
+	}
+}
+
+func (p *scheduleParser) errorf(format string, args ...interface{}) *ParseError {
+	return &ParseError{Input: p.input, Pos: p.pos, Msg: fmt.Sprintf(format, args...)}
+}
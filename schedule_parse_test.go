@@ -0,0 +1,138 @@
+package timechart
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSchedule(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected Schedule
+		err      *ParseError
+	}{
+		{
+			input:    "09:00-12:30",
+			expected: Schedule{newTime(9, 0), newTime(12, 30)},
+		},
+		{
+			input:    "9-17",
+			expected: Schedule{newTime(9, 0), newTime(17, 0)},
+		},
+		{
+			input:    " 9:30 - 17 ",
+			expected: Schedule{newTime(9, 30), newTime(17, 0)},
+		},
+		{
+			input:    "22:00-02:00+1",
+			expected: Schedule{newTime(22, 0), newTime(26, 0)},
+		},
+		{
+			input:    "18:00-24:00",
+			expected: Schedule{newTime(18, 0), newTime(24, 0)},
+		},
+		{
+			input: "",
+			err:   &ParseError{Input: "", Pos: 0, Msg: "missing schedule"},
+		},
+		{
+			input: "09:00",
+			err:   &ParseError{Input: "09:00", Pos: 5, Msg: "expected '-'"},
+		},
+		{
+			input: "09:00-noon",
+			err:   &ParseError{Input: "09:00-noon", Pos: 6, Msg: "expected hour"},
+		},
+		{
+			input: "9:5-10",
+			err:   &ParseError{Input: "9:5-10", Pos: 2, Msg: "expected minutes of 2 digits"},
+		},
+		{
+			input: "09:60-10:00",
+			err:   &ParseError{Input: "09:60-10:00", Pos: 3, Msg: "minute 60 out of range"},
+		},
+		{
+			input: "09:00-25:00",
+			err:   &ParseError{Input: "09:00-25:00", Pos: 6, Msg: "25:00 out of range"},
+		},
+		{
+			input: "22:00-02:00",
+			err:   &ParseError{Input: "22:00-02:00", Pos: 6, Msg: "ends before it starts, add +1 to end on the next day"},
+		},
+		{
+			input: "22:00-02:00+",
+			err:   &ParseError{Input: "22:00-02:00+", Pos: 12, Msg: "expected days after '+'"},
+		},
+		{
+			input: "09:00-10:00am",
+			err:   &ParseError{Input: "09:00-10:00am", Pos: 11, Msg: "unexpected 'a'"},
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.input, func(t *testing.T) {
+			got, err := ParseSchedule(tc.input)
+			if tc.err != nil {
+				assert.Equal(t, tc.err, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestParseSchedules(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected []Schedule
+		err      *ParseError
+	}{
+		{
+			input: "09:00-12:30, 13:30-18:00",
+			expected: []Schedule{
+				{newTime(9, 0), newTime(12, 30)},
+				{newTime(13, 30), newTime(18, 0)},
+			},
+		},
+		{
+			input: "9-10,22-2+1",
+			expected: []Schedule{
+				{newTime(9, 0), newTime(10, 0)},
+				{newTime(22, 0), newTime(26, 0)},
+			},
+		},
+		{
+			input: " ",
+		},
+		{
+			input: "09:00-12:30, 13:30-1800",
+			err:   &ParseError{Input: "09:00-12:30, 13:30-1800", Pos: 21, Msg: "unexpected '0'"},
+		},
+		{
+			input: "09:00-12:30,",
+			err:   &ParseError{Input: "09:00-12:30,", Pos: 12, Msg: "missing schedule"},
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.input, func(t *testing.T) {
+			got, err := ParseSchedules(tc.input)
+			if tc.err != nil {
+				assert.Equal(t, tc.err, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestParseSchedule_string(t *testing.T) {
+	s := Schedule{newTime(9, 5), newTime(17, 45)}
+
+	got, err := ParseSchedule(s.String())
+	assert.NoError(t, err)
+	assert.Equal(t, s, got)
+}
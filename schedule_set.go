@@ -0,0 +1,95 @@
+package timechart
+
+// ScheduleSet is a set of times made of disjoint, non-empty schedules sorted by their start.
+// Each schedule of a set covers [Start, End), so 09:00-12:00 and 12:00-13:00 don't intersect.
+// Build one with NewScheduleSet; every operation returns a new set and leaves its operands untouched.
+type ScheduleSet []Schedule
+
+// NewScheduleSet returns the set of times covered by any of ss.
+// e.g. if [14:00-16:00, 13:00-15:00, 17:00-17:00] are given, returns [13:00-16:00]
+func NewScheduleSet(ss ...Schedule) ScheduleSet {
+	var set ScheduleSet
+	for _, schedule := range OverlapSchedules(ss) {
+		if timeLT(schedule.Start, schedule.End) {
+			set = append(set, schedule)
+		}
+	}
+	return set
+}
+
+// Union returns the times in s or other.
+func (s ScheduleSet) Union(other ScheduleSet) ScheduleSet {
+	ss := make([]Schedule, 0, len(s)+len(other))
+	ss = append(ss, s...)
+	return NewScheduleSet(append(ss, other...)...)
+}
+
+// Intersect returns the times in both s and other.
+func (s ScheduleSet) Intersect(other ScheduleSet) ScheduleSet {
+	//   ├──────┤     s
+	// ∩     ├──────┤ other
+	// =     ├──┤
+	var set ScheduleSet
+	for i, j := 0, 0; i < len(s) && j < len(other); {
+		start, end := s[i].Start, s[i].End
+		if timeGT(other[j].Start, start) {
+			start = other[j].Start
+		}
+		if timeLT(other[j].End, end) {
+			end = other[j].End
+		}
+		if timeLT(start, end) {
+			set = append(set, NewSchedule(start, end))
+		}
+
+		if timeLT(s[i].End, other[j].End) {
+			i++
+		} else {
+			j++
+		}
+	}
+	return set
+}
+
+// Subtract returns the times in s but not in other.
+func (s ScheduleSet) Subtract(other ScheduleSet) ScheduleSet {
+	//   ├──────┤     s
+	// -     ├──────┤ other
+	// = ├───┤
+	var set ScheduleSet
+	j := 0
+	for _, schedule := range s {
+		for j < len(other) && timeLTE(other[j].End, schedule.Start) {
+			j++ // ends before any of the rest of s
+		}
+		start := schedule.Start
+		for k := j; k < len(other) && timeLT(other[k].Start, schedule.End); k++ {
+			if timeLT(start, other[k].Start) {
+				set = append(set, NewSchedule(start, other[k].Start))
+			}
+			if timeGT(other[k].End, start) {
+				start = other[k].End
+			}
+		}
+		if timeLT(start, schedule.End) {
+			set = append(set, NewSchedule(start, schedule.End))
+		}
+	}
+	return set
+}
+
+// Complement returns the times in window but not in s,
+// e.g. free time when s is busy time and window is working hours.
+func (s ScheduleSet) Complement(window Schedule) ScheduleSet {
+	return NewScheduleSet(window).Subtract(s)
+}
+
+// Gaps returns the times between the schedules of s.
+// e.g. if s is [09:00-10:00, 11:00-12:00, 15:00-16:00], returns [10:00-11:00, 12:00-15:00]
+func (s ScheduleSet) Gaps() ScheduleSet {
+	var set ScheduleSet
+	for i := 1; i < len(s); i++ {
+		set = append(set, NewSchedule(s[i-1].End, s[i].Start))
+	}
+	return set
+}
@@ -0,0 +1,120 @@
+package timechart
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewScheduleSet(t *testing.T) {
+	got := NewScheduleSet(
+		Schedule{newTime(14, 0), newTime(16, 0)},
+		Schedule{newTime(17, 0), newTime(17, 0)},
+		Schedule{newTime(13, 0), newTime(15, 0)},
+	)
+	assert.Equal(t, ScheduleSet{{newTime(13, 0), newTime(16, 0)}}, got)
+	assert.Nil(t, NewScheduleSet())
+}
+
+func TestScheduleSet(t *testing.T) {
+	cases := []struct {
+		name      string
+		s         ScheduleSet
+		other     ScheduleSet
+		union     ScheduleSet
+		intersect ScheduleSet
+		subtract  ScheduleSet
+	}{
+		{
+			name:      "empty",
+			s:         nil,
+			other:     NewScheduleSet(Schedule{newTime(9, 0), newTime(12, 0)}),
+			union:     ScheduleSet{{newTime(9, 0), newTime(12, 0)}},
+			intersect: nil,
+			subtract:  nil,
+		},
+		{
+			name:      "overlapped",
+			s:         NewScheduleSet(Schedule{newTime(9, 0), newTime(12, 0)}),
+			other:     NewScheduleSet(Schedule{newTime(11, 0), newTime(14, 0)}),
+			union:     ScheduleSet{{newTime(9, 0), newTime(14, 0)}},
+			intersect: ScheduleSet{{newTime(11, 0), newTime(12, 0)}},
+			subtract:  ScheduleSet{{newTime(9, 0), newTime(11, 0)}},
+		},
+		{
+			name:      "touching",
+			s:         NewScheduleSet(Schedule{newTime(9, 0), newTime(12, 0)}),
+			other:     NewScheduleSet(Schedule{newTime(12, 0), newTime(13, 0)}),
+			union:     ScheduleSet{{newTime(9, 0), newTime(13, 0)}},
+			intersect: nil,
+			subtract:  ScheduleSet{{newTime(9, 0), newTime(12, 0)}},
+		},
+		{
+			name: "many",
+			s: NewScheduleSet(
+				Schedule{newTime(9, 0), newTime(18, 0)},
+				Schedule{newTime(20, 0), newTime(22, 0)},
+			),
+			other: NewScheduleSet(
+				Schedule{newTime(8, 0), newTime(10, 0)},
+				Schedule{newTime(12, 0), newTime(13, 0)},
+				Schedule{newTime(15, 0), newTime(16, 30)},
+				Schedule{newTime(21, 0), newTime(23, 0)},
+			),
+			union: ScheduleSet{
+				{newTime(8, 0), newTime(18, 0)},
+				{newTime(20, 0), newTime(23, 0)},
+			},
+			intersect: ScheduleSet{
+				{newTime(9, 0), newTime(10, 0)},
+				{newTime(12, 0), newTime(13, 0)},
+				{newTime(15, 0), newTime(16, 30)},
+				{newTime(21, 0), newTime(22, 0)},
+			},
+			subtract: ScheduleSet{
+				{newTime(10, 0), newTime(12, 0)},
+				{newTime(13, 0), newTime(15, 0)},
+				{newTime(16, 30), newTime(18, 0)},
+				{newTime(20, 0), newTime(21, 0)},
+			},
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.union, tc.s.Union(tc.other), "union")
+			assert.Equal(t, tc.intersect, tc.s.Intersect(tc.other), "intersect")
+			assert.Equal(t, tc.subtract, tc.s.Subtract(tc.other), "subtract")
+		})
+	}
+}
+
+func TestScheduleSet_Complement(t *testing.T) {
+	busy := NewScheduleSet(
+		Schedule{newTime(8, 0), newTime(9, 30)},
+		Schedule{newTime(12, 0), newTime(13, 0)},
+		Schedule{newTime(17, 0), newTime(19, 0)},
+	)
+	working := NewSchedule(newTime(9, 0), newTime(18, 0))
+
+	got := busy.Complement(working)
+	assert.Equal(t, ScheduleSet{
+		{newTime(9, 30), newTime(12, 0)},
+		{newTime(13, 0), newTime(17, 0)},
+	}, got)
+}
+
+func TestScheduleSet_Gaps(t *testing.T) {
+	s := NewScheduleSet(
+		Schedule{newTime(9, 0), newTime(10, 0)},
+		Schedule{newTime(15, 0), newTime(16, 0)},
+		Schedule{newTime(11, 0), newTime(12, 0)},
+	)
+
+	got := s.Gaps()
+	assert.Equal(t, ScheduleSet{
+		{newTime(10, 0), newTime(11, 0)},
+		{newTime(12, 0), newTime(15, 0)},
+	}, got)
+	assert.Nil(t, s[:1].Gaps())
+}
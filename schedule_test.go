@@ -1,7 +1,10 @@
 package timechart
 
 import (
+	"math/rand"
+	"reflect"
 	"testing"
+	"testing/quick"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
@@ -76,16 +79,193 @@ func TestOverlapSchedules(t *testing.T) {
 				{newTime(12, 0), newTime(15, 0)},
 			},
 		},
+		{
+			name: "bridged",
+			schedules: []Schedule{
+				{newTime(1, 0), newTime(2, 0)},
+				{newTime(3, 0), newTime(4, 0)},
+				{newTime(1, 30), newTime(3, 30)},
+			},
+			expected: []Schedule{
+				{newTime(1, 0), newTime(4, 0)},
+			},
+		},
+		{
+			name: "sorted",
+			schedules: []Schedule{
+				{newTime(16, 0), newTime(19, 0)},
+				{newTime(9, 0), newTime(10, 0)},
+				{newTime(12, 0), newTime(15, 0)},
+			},
+			expected: []Schedule{
+				{newTime(9, 0), newTime(10, 0)},
+				{newTime(12, 0), newTime(15, 0)},
+				{newTime(16, 0), newTime(19, 0)},
+			},
+		},
 	}
 	for _, tc := range cases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
+			given := append([]Schedule(nil), tc.schedules...)
+
 			got := OverlapSchedules(tc.schedules)
+			assert.Equal(t, given, tc.schedules, "must not modify given schedules")
 			assert.Equal(t, tc.expected, got, cmp.Diff(tc.expected, got))
 		})
 	}
 }
 
+// randomSchedules are valid schedules on a day, of which times are multiples of 10 minutes.
+type randomSchedules []Schedule
+
+func (randomSchedules) Generate(r *rand.Rand, size int) reflect.Value {
+	ss := make(randomSchedules, r.Intn(size+1))
+	for i := range ss {
+		start := r.Intn(24 * 6)
+		end := start + r.Intn(24*6-start+1)
+		ss[i] = Schedule{newTime(0, start*10), newTime(0, end*10)}
+	}
+	return reflect.ValueOf(ss)
+}
+
+func (ss randomSchedules) covers(t time.Time) bool {
+	for _, s := range ss {
+		if s.Contains(t) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestOverlapSchedules_quick(t *testing.T) {
+	t.Run("disjoint and sorted", func(t *testing.T) {
+		f := func(ss randomSchedules) bool {
+			got := OverlapSchedules(ss)
+			for i := 1; i < len(got); i++ {
+				if !timeGT(got[i].Start, got[i-1].End) {
+					return false
+				}
+			}
+			return true
+		}
+		assert.NoError(t, quick.Check(f, nil))
+	})
+	t.Run("same coverage", func(t *testing.T) {
+		f := func(ss randomSchedules) bool {
+			got := randomSchedules(OverlapSchedules(ss))
+			for m := 0; m <= 24*60; m += 5 {
+				if ss.covers(newTime(0, m)) != got.covers(newTime(0, m)) {
+					return false
+				}
+			}
+			return true
+		}
+		assert.NoError(t, quick.Check(f, nil))
+	})
+	t.Run("idempotent", func(t *testing.T) {
+		f := func(ss randomSchedules) bool {
+			got := OverlapSchedules(ss)
+			return reflect.DeepEqual(got, OverlapSchedules(got))
+		}
+		assert.NoError(t, quick.Check(f, nil))
+	})
+	t.Run("order insensitive", func(t *testing.T) {
+		f := func(ss randomSchedules, seed int64) bool {
+			shuffled := append(randomSchedules(nil), ss...)
+			rand.New(rand.NewSource(seed)).Shuffle(len(shuffled), func(i, j int) {
+				shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+			})
+			return reflect.DeepEqual(OverlapSchedules(ss), OverlapSchedules(shuffled))
+		}
+		assert.NoError(t, quick.Check(f, nil))
+	})
+	t.Run("not aliasing", func(t *testing.T) {
+		f := func(ss randomSchedules) bool {
+			given := make(randomSchedules, len(ss))
+			copy(given, ss)
+			OverlapSchedules(ss)
+			return reflect.DeepEqual(given, ss)
+		}
+		assert.NoError(t, quick.Check(f, nil))
+	})
+}
+
+func TestSchedule_Validate(t *testing.T) {
+	seoul := time.FixedZone("Asia/Seoul", 9*60*60)
+	cases := []struct {
+		name     string
+		schedule Schedule
+		expected error
+	}{
+		{
+			name:     "valid",
+			schedule: Schedule{newTime(12, 0), newTime(16, 0)},
+		},
+		{
+			name:     "empty",
+			schedule: Schedule{newTime(12, 0), newTime(12, 0)},
+		},
+		{
+			name:     "end before start",
+			schedule: Schedule{newTime(16, 0), newTime(12, 0)},
+			expected: ErrEndBeforeStart,
+		},
+		{
+			name:     "from midnight",
+			schedule: Schedule{newTime(0, 0), newTime(9, 0)},
+		},
+		{
+			name:     "zero",
+			schedule: Schedule{},
+			expected: ErrZeroTime,
+		},
+		{
+			name:     "zero end",
+			schedule: Schedule{time.Date(2022, 2, 1, 9, 0, 0, 0, time.UTC), time.Time{}},
+			expected: ErrZeroTime,
+		},
+		{
+			name:     "zero start",
+			schedule: Schedule{time.Time{}, time.Date(2022, 2, 1, 9, 0, 0, 0, time.UTC)},
+			expected: ErrZeroTime,
+		},
+		{
+			name:     "time of day to date",
+			schedule: Schedule{newTime(9, 0), time.Date(2022, 2, 1, 9, 0, 0, 0, time.UTC)},
+			expected: ErrOutOfRange,
+		},
+		{
+			name:     "location mismatch",
+			schedule: Schedule{newTime(12, 0), time.Date(1, 1, 1, 16, 0, 0, 0, seoul)},
+			expected: ErrLocationMismatch,
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.schedule.Validate()
+			assert.ErrorIs(t, err, tc.expected)
+
+			_, err = NewValidSchedule(tc.schedule.Start, tc.schedule.End)
+			assert.ErrorIs(t, err, tc.expected)
+		})
+	}
+}
+
+func TestValidateSchedules(t *testing.T) {
+	err := ValidateSchedules([]Schedule{
+		{newTime(12, 0), newTime(16, 0)},
+		{newTime(16, 0), newTime(12, 0)},
+	})
+
+	var scheduleErr *ScheduleError
+	if assert.ErrorAs(t, err, &scheduleErr) {
+		assert.Equal(t, 1, scheduleErr.Index)
+		assert.ErrorIs(t, err, ErrEndBeforeStart)
+	}
+}
+
 func newTime(h, m int) time.Time {
 	return NewTime(h, m, 0)
 }
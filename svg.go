@@ -0,0 +1,250 @@
+package timechart
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SVGStyle is the size and the colors of charts drawn by SVGFormatter.
+// Colors are of CSS, like "#2e7d32" or "green", and an empty one draws nothing.
+type SVGStyle struct {
+	Width  int // of the image in pixels
+	Height int // of the image in pixels, including the header if any
+
+	Background  string
+	Line        string // of segments, edges and hour ticks
+	Fill        string // of Busy schedules
+	Tentative   string // of Tentative schedules
+	OutOfOffice string // of OutOfOffice schedules
+	Now         string // of the line marking now
+	Text        string // of the header
+}
+
+// DefaultSVGStyle draws a chart 740 pixels wide, like the text of HalfHourIncrementFormatter ten times as large,
+// in the colors of DefaultPalette.
+var DefaultSVGStyle = SVGStyle{
+	Width:       740,
+	Height:      40,
+	Background:  "white",
+	Line:        "#9e9e9e",
+	Fill:        "#2e7d32",
+	Tentative:   "#81c784",
+	OutOfOffice: "#5c6bc0",
+	Now:         "#d32f2f",
+	Text:        "#424242",
+}
+
+// SVGFormatter draws schedules as an SVG image of the layout of its IncrementFormatter.
+// Each character of the text chart becomes a column of the image, where slots are bars
+// and hours and edges are lines, so options like WithWindow and WithPartialSlots apply alike.
+type SVGFormatter struct {
+	f     IncrementFormatter
+	style SVGStyle
+}
+
+var _ CheckedFormatter = SVGFormatter{}
+
+func NewSVGFormatter(f IncrementFormatter, style SVGStyle) SVGFormatter {
+	return SVGFormatter{f: f, style: style}
+}
+
+func (g SVGFormatter) Format(ss []Schedule) string {
+	return g.FormatCategories(categorize(ss))
+}
+
+func (g SVGFormatter) FormatNow(ss []Schedule) string {
+	return g.FormatWithTime(ss, time.Now())
+}
+
+func (g SVGFormatter) FormatWithTime(ss []Schedule, t time.Time) string {
+	return g.FormatCategoriesWithTime(categorize(ss), t)
+}
+
+// FormatCategories is like Format but fills each schedule by its category.
+func (g SVGFormatter) FormatCategories(cs []CategorizedSchedule) string {
+	return g.draw(cs, nil)
+}
+
+// FormatCategoriesWithTime is like FormatWithTime but fills each schedule by its category.
+func (g SVGFormatter) FormatCategoriesWithTime(cs []CategorizedSchedule, t time.Time) string {
+	return g.draw(cs, &t)
+}
+
+// FormatChecked is like Format but returns a *ScheduleError if any of ss is invalid.
+func (g SVGFormatter) FormatChecked(ss []Schedule) (string, error) {
+	if err := ValidateSchedules(ss); err != nil {
+		return "", err
+	}
+	return g.Format(ss), nil
+}
+
+// FormatWithTimeChecked is like FormatWithTime but returns a *ScheduleError if any of ss is invalid.
+func (g SVGFormatter) FormatWithTimeChecked(ss []Schedule, t time.Time) (string, error) {
+	if err := ValidateSchedules(ss); err != nil {
+		return "", err
+	}
+	return g.FormatWithTime(ss, t), nil
+}
+
+// draw returns the image of cs, marking now if given.
+func (g SVGFormatter) draw(cs []CategorizedSchedule, now *time.Time) string {
+	day := g.f.day(cs, now)
+	l := g.f.dayLayout(day)
+	f := g.f
+	f.fn = newSVGChar
+	cells := f.fill(cs, day)
+
+	s := g.style
+	w := float64(s.Width) / float64(len(l)) // of a column
+	top, bottom := 0.0, float64(s.Height)
+	if g.f.header != 0 {
+		top = bottom / 3
+	}
+	mid := (top + bottom) / 2
+	tick := (bottom - top) / 4 // half the height of an hour tick
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`+"\n",
+		s.Width, s.Height, s.Width, s.Height)
+	if s.Background != "" {
+		fmt.Fprintf(&b, `<rect width="%d" height="%d" fill="%s"/>`+"\n", s.Width, s.Height, s.Background)
+	}
+
+	if g.f.header != 0 && s.Text != "" {
+		for _, label := range l.labels(g.f.header) {
+			fmt.Fprintf(&b, `<text x="%s" y="%s" font-family="sans-serif" font-size="%s" text-anchor="middle" fill="%s">%s</text>`+"\n",
+				px(w*(float64(label.column)+0.5)), px(top*0.8), px(top*0.7), s.Text, label.text)
+		}
+	}
+
+	// bars
+	for i, c := range cells {
+		ch := c.(svgChar)
+		color := s.fillColor(ch.category)
+		if !ch.in || color == "" || l[i].t == hour && ch.start && ch.end {
+			continue // not filled, or by a schedule lasting for no time
+		}
+		x0, x1 := w*float64(i), w*float64(i+1)
+		switch {
+		case l[i].t == slot && ch.partial:
+			x0, x1 = x0+w*ch.from, x0+w*ch.to
+		case l[i].t == edge && l[i].start, l[i].t == hour && ch.start:
+			x0 += w / 2
+		case l[i].t == edge, l[i].t == hour && ch.end:
+			x1 -= w / 2
+		}
+		fmt.Fprintf(&b, `<rect x="%s" y="%s" width="%s" height="%s" fill="%s"/>`+"\n",
+			px(x0), px(mid-tick), px(x1-x0), px(2*tick), color)
+	}
+
+	// segments, edges and hour ticks
+	if s.Line != "" {
+		for i, c := range l {
+			x := px(w * (float64(i) + 0.5))
+			switch {
+			case c.t == edge && c.start:
+				end := i
+				for end < len(l) && !(l[end].t == edge && !l[end].start) {
+					end++
+				}
+				fmt.Fprintf(&b, `<line x1="%s" y1="%s" x2="%s" y2="%s" stroke="%s"/>`+"\n",
+					x, px(mid), px(w*(float64(end)+0.5)), px(mid), s.Line)
+				fallthrough
+			case c.t == edge:
+				fmt.Fprintf(&b, `<line x1="%s" y1="%s" x2="%s" y2="%s" stroke="%s"/>`+"\n",
+					x, px(top), x, px(bottom), s.Line)
+			case c.t == hour:
+				fmt.Fprintf(&b, `<line x1="%s" y1="%s" x2="%s" y2="%s" stroke="%s"/>`+"\n",
+					x, px(mid-tick), x, px(mid+tick), s.Line)
+			}
+		}
+	}
+
+	if now != nil && s.Now != "" && l.contains(g.f.at(*now, day)) {
+		at := g.f.at(*now, day)
+		i := l.nowIndex(at)
+		x := w * (float64(i) + 0.5)
+		if c := l[i]; c.t == slot {
+			x = w * (float64(i) + float64(at.Sub(c.from))/float64(c.to.Sub(c.from)))
+		}
+		fmt.Fprintf(&b, `<line x1="%s" y1="%s" x2="%s" y2="%s" stroke="%s" stroke-width="2"/>`+"\n",
+			px(x), px(top), px(x), px(bottom), s.Now)
+	}
+
+	b.WriteString("</svg>\n")
+	return b.String()
+}
+
+// fillColor returns the color of schedules of category.
+func (s SVGStyle) fillColor(category Category) string {
+	switch category {
+	case Tentative:
+		return s.Tentative
+	case OutOfOffice:
+		return s.OutOfOffice
+	default:
+		return s.Fill
+	}
+}
+
+// px returns v rounded to hundredths of a pixel.
+func px(v float64) string {
+	return strconv.FormatFloat(math.Round(v*100)/100, 'f', -1, 64)
+}
+
+// svgChar records how a cell is drawn for SVGFormatter, instead of drawing it as a character.
+type svgChar struct {
+	start    bool
+	end      bool
+	in       bool
+	category Category
+	partial  bool
+	from, to float64
+}
+
+var (
+	_ CategoryChar = (*svgChar)(nil)
+	_ PartialChar  = (*svgChar)(nil)
+)
+
+func newSVGChar() Char {
+	return svgChar{}
+}
+
+func (c svgChar) Now() Char  { return c }
+func (c svgChar) Hour() Char { return c }
+func (c svgChar) Edge() Char { return c }
+func (c svgChar) Slot() Char { return c }
+
+func (c svgChar) Start() Char {
+	c.start = true
+	return c
+}
+
+func (c svgChar) End() Char {
+	c.end = true
+	return c
+}
+
+func (c svgChar) Fill() Char {
+	c.in = true
+	return c
+}
+
+func (c svgChar) Category(category Category) Char {
+	c.category = category
+	return c
+}
+
+func (c svgChar) Partial(from, to float64) Char {
+	c.partial = true
+	c.from, c.to = from, to
+	return c
+}
+
+func (c svgChar) String() string {
+	return ""
+}
@@ -0,0 +1,73 @@
+package timechart
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var update = flag.Bool("update", false, "update golden files in testdata")
+
+func TestSVGFormatter(t *testing.T) {
+	cs := []CategorizedSchedule{
+		{Schedule: Schedule{newTime(9, 0), newTime(12, 30)}, Category: Busy},
+		{Schedule: Schedule{newTime(13, 10), newTime(13, 20)}, Category: Tentative},
+		{Schedule: Schedule{newTime(15, 0), newTime(18, 0)}, Category: OutOfOffice},
+	}
+	now := time.Date(2022, 2, 1, 10, 15, 0, 0, time.UTC)
+	custom := SVGStyle{
+		Width:  370,
+		Height: 60,
+		Line:   "black",
+		Fill:   "orange",
+		Now:    "blue",
+		Text:   "black",
+	}
+
+	cases := []struct {
+		name  string
+		f     IncrementFormatter
+		style SVGStyle
+	}{
+		{
+			name:  "half-hour",
+			f:     NewHalfHourIncrementFormatter(NewUnicodeChar).IncrementFormatter,
+			style: DefaultSVGStyle,
+		},
+		{
+			name:  "partial-slots",
+			f:     NewHalfHourIncrementFormatter(NewUnicodeChar, WithPartialSlots()).IncrementFormatter,
+			style: DefaultSVGStyle,
+		},
+		{
+			name:  "custom-style",
+			f:     NewHalfHourIncrementFormatter(NewUnicodeChar, WithHeader(3*time.Hour), WithWindow(8*time.Hour, 20*time.Hour)).IncrementFormatter,
+			style: custom,
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got := NewSVGFormatter(tc.f, tc.style).FormatCategoriesWithTime(cs, now)
+
+			golden := filepath.Join("testdata", "svg", tc.name+".svg")
+			if *update {
+				assert.NoError(t, os.WriteFile(golden, []byte(got), 0o644))
+			}
+			expected, err := os.ReadFile(golden)
+			assert.NoError(t, err)
+			assert.Equal(t, string(expected), got)
+		})
+	}
+}
+
+func TestSVGFormatter_FormatChecked(t *testing.T) {
+	g := NewSVGFormatter(NewHalfHourIncrementFormatter(NewUnicodeChar).IncrementFormatter, DefaultSVGStyle)
+
+	_, err := g.FormatChecked([]Schedule{{newTime(10, 0), newTime(9, 0)}})
+	assert.ErrorIs(t, err, ErrEndBeforeStart)
+}